@@ -0,0 +1,406 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// hmacSecret is a secret of the minimum length
+const hmacSecret = "0123456789abcdef0123456789abcdef"
+
+// writeFile writes data to name in dir and returns its path
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// writePEM writes one PEM block of the given type to name in dir and returns its path
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	return writeFile(t, dir, name, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+// writePrivateKey writes key as a PKCS #8 PEM file for Sign and returns its path
+func writePrivateKey(t *testing.T, dir, name string, key crypto.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, dir, name, "PRIVATE KEY", der)
+}
+
+// writePublicKey writes key as a PKIX PEM file and returns its path
+func writePublicKey(t *testing.T, dir, name string, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, dir, name, "PUBLIC KEY", der)
+}
+
+// writeCertificate writes a self-signed certificate of key and returns its path
+func writeCertificate(t *testing.T, dir, name string, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "token issuer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, dir, name, "CERTIFICATE", der)
+}
+
+// base64URL encodes a number of a JWK
+func base64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// rsaJWK and ecJWK return the JWK of a public key
+func rsaJWK(kid string, key *rsa.PublicKey) jwk {
+	return jwk{Kty: "RSA", Kid: kid, Use: "sig", N: base64URL(key.N.Bytes()), E: base64URL(big.NewInt(int64(key.E)).Bytes())}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) jwk {
+	return jwk{Kty: "EC", Kid: kid, Crv: key.Curve.Params().Name, X: base64URL(key.X.Bytes()), Y: base64URL(key.Y.Bytes())}
+}
+
+// writeJWKS writes a JWKS of keys and returns its path
+func writeJWKS(t *testing.T, dir, name string, keys ...jwk) string {
+	t.Helper()
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writeFile(t, dir, name, data)
+}
+
+// testKeys are the signing keys of the tests, RSA keys are slow to generate so they are made once
+type testKeys struct {
+	rsa      *rsa.PrivateKey
+	ec, ec2  *ecdsa.PrivateKey
+	ed25519  ed25519.PublicKey
+	rsaFile  string // private keys for Sign
+	ecFile   string
+	ec2File  string
+	hmacFile string
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	dir := t.TempDir()
+	k := &testKeys{}
+	var err error
+	if k.rsa, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []**ecdsa.PrivateKey{&k.ec, &k.ec2} {
+		if *key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if k.ed25519, _, err = ed25519.GenerateKey(rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+	k.rsaFile = writePrivateKey(t, dir, "rsa.key", k.rsa)
+	k.ecFile = writePrivateKey(t, dir, "ec.key", k.ec)
+	k.ec2File = writePrivateKey(t, dir, "ec2.key", k.ec2)
+	k.hmacFile = writeFile(t, dir, "hmac.secret", []byte(hmacSecret+"\n"))
+	return k
+}
+
+func TestLoadKeys(t *testing.T) {
+	keys := newTestKeys(t)
+	dir := t.TempDir()
+	rsaPKCS1 := writePEM(t, dir, "rsa-pkcs1.pub", "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&keys.rsa.PublicKey))
+	offCurve := ecJWK("bad", &keys.ec.PublicKey)
+	offCurve.Y = offCurve.X
+	tests := []struct {
+		name        string
+		opts        KeyOptions
+		wantMethods []string // first signing methods accepted
+		wantErr     string
+	}{
+		{"none", KeyOptions{}, nil, "no token verification key configured"},
+		{"hmac", KeyOptions{HMACSecretFile: keys.hmacFile}, []string{"HS256"}, ""},
+		{"hmac too short", KeyOptions{HMACSecretFile: writeFile(t, dir, "short", []byte(hmacSecret[1:]))}, nil, "at least 32 bytes"},
+		{"hmac newline is not part of the secret", KeyOptions{HMACSecretFile: writeFile(t, dir, "short-newline", []byte(hmacSecret[1:]+"\r\n"))},
+			nil, "at least 32 bytes"},
+		{"hmac missing file", KeyOptions{HMACSecretFile: filepath.Join(dir, "missing")}, nil, "error while reading HMAC secret"},
+		{"rsa public key", KeyOptions{PublicKeyFiles: []string{writePublicKey(t, dir, "rsa.pub", &keys.rsa.PublicKey)}},
+			[]string{"RS256"}, ""},
+		{"rsa pkcs1 public key", KeyOptions{PublicKeyFiles: []string{rsaPKCS1}}, []string{"RS256"}, ""},
+		{"ec certificate", KeyOptions{PublicKeyFiles: []string{writeCertificate(t, dir, "ec.crt", keys.ec)}}, []string{"ES256"}, ""},
+		{"ed25519 public key", KeyOptions{PublicKeyFiles: []string{writePublicKey(t, dir, "ed.pub", keys.ed25519)}},
+			nil, "unsupported public key type"},
+		{"not pem", KeyOptions{PublicKeyFiles: []string{writeFile(t, dir, "not.pem", []byte("key"))}}, nil, "no PEM data"},
+		{"bad certificate", KeyOptions{PublicKeyFiles: []string{writePEM(t, dir, "bad.crt", "CERTIFICATE", []byte("x"))}},
+			nil, "invalid certificate"},
+		{"jwks", KeyOptions{JWKSFile: writeJWKS(t, dir, "keys.json", rsaJWK("r", &keys.rsa.PublicKey), ecJWK("e", &keys.ec.PublicKey))},
+			[]string{"RS256"}, ""},
+		{"jwks encryption keys skipped", KeyOptions{JWKSFile: writeJWKS(t, dir, "enc.json",
+			jwk{Kty: "RSA", Kid: "enc", Use: "enc"})}, nil, "no token verification key configured"},
+		{"jwks off curve", KeyOptions{JWKSFile: writeJWKS(t, dir, "off.json", offCurve)}, nil, "point is not on curve"},
+		{"jwks unknown type", KeyOptions{JWKSFile: writeJWKS(t, dir, "oct.json", jwk{Kty: "oct", Kid: "o"})}, nil, `unsupported key type "oct"`},
+		{"jwks bad number", KeyOptions{JWKSFile: writeJWKS(t, dir, "num.json", jwk{Kty: "RSA", N: "!", E: "AQAB"})}, nil, "invalid base64url"},
+		{"jwks not json", KeyOptions{JWKSFile: writeFile(t, dir, "jwks.txt", []byte("keys"))}, nil, "cannot parse JWKS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := LoadKeys(tt.opts)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadKeys error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadKeys: %v", err)
+			}
+			methods := strings.Join(set.methods(), " ")
+			for _, method := range tt.wantMethods {
+				if !strings.Contains(methods, method) {
+					t.Errorf("methods %v, want %v", methods, method)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyRule(t *testing.T) {
+	policy := Policy{
+		"/blog.v1.BlogService/*":          {Scopes: []string{"blog.read"}},
+		"/blog.v1.BlogService/DeleteBlog": {Roles: []string{"admin"}},
+		"/grpc.health.v1.Health/*":        {Public: true},
+	}
+	tests := []struct {
+		method string
+		want   string // key of the rule, none for the empty rule
+	}{
+		{"/blog.v1.BlogService/DeleteBlog", "/blog.v1.BlogService/DeleteBlog"},
+		{"/blog.v1.BlogService/ReadBlog", "/blog.v1.BlogService/*"},
+		{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/*"},
+		{"/blog.v2.BlogService/GetBlog", ""},
+		{"/greet.v1.GreetService/Greet", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			got := policy.rule(tt.method)
+			want := policy[tt.want]
+			if got.Public != want.Public || strings.Join(got.Scopes, " ") != strings.Join(want.Scopes, " ") ||
+				strings.Join(got.Roles, " ") != strings.Join(want.Roles, " ") {
+				t.Errorf("rule(%v) = %+v, want the rule of %q %+v", tt.method, got, tt.want, want)
+			}
+		})
+	}
+}
+
+// errorInfo returns the ErrorInfo details of err
+func errorInfo(err error) *errdetails.ErrorInfo {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func TestAuthorize(t *testing.T) {
+	policy := Policy{
+		"/blog.v1.BlogService/*":          {Scopes: []string{"blog.read"}},
+		"/blog.v1.BlogService/UpdateBlog": {Scopes: []string{"blog.read", "blog.write"}},
+		"/blog.v1.BlogService/DeleteBlog": {Scopes: []string{"blog.write"}, Roles: []string{"admin", "editor"}},
+	}
+	tests := []struct {
+		name         string
+		method       string
+		scope        string
+		roles        []string
+		wantReason   string // none if allowed
+		wantMetadata string // metadata key and value of the missing scope or roles
+	}{
+		{"no rule", "/greet.v1.GreetService/Greet", "", nil, "", ""},
+		{"wildcard scope", "/blog.v1.BlogService/ReadBlog", "blog.read", nil, "", ""},
+		{"wildcard scope missing", "/blog.v1.BlogService/ReadBlog", "blog.write", nil, rpcerr.ReasonMissingScope, "scope=blog.read"},
+		{"every scope needed", "/blog.v1.BlogService/UpdateBlog", "blog.read", nil, rpcerr.ReasonMissingScope, "scope=blog.write"},
+		{"every scope given", "/blog.v1.BlogService/UpdateBlog", "blog.write blog.read", nil, "", ""},
+		{"one of the roles", "/blog.v1.BlogService/DeleteBlog", "blog.write", []string{"editor"}, "", ""},
+		{"no role", "/blog.v1.BlogService/DeleteBlog", "blog.write", []string{"reader"}, rpcerr.ReasonMissingRole, "roles=admin,editor"},
+		{"scope checked before roles", "/blog.v1.BlogService/DeleteBlog", "", []string{"admin"}, rpcerr.ReasonMissingScope, "scope=blog.write"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.authorize(tt.method, &Claims{Scope: tt.scope, Roles: tt.roles})
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("authorize: %v", err)
+				}
+				return
+			}
+			if code := status.Code(err); code != codes.PermissionDenied {
+				t.Fatalf("authorize: %v, want PERMISSION_DENIED", err)
+			}
+			info := errorInfo(err)
+			if info.GetReason() != tt.wantReason {
+				t.Errorf("reason %q, want %v", info.GetReason(), tt.wantReason)
+			}
+			key, value, _ := strings.Cut(tt.wantMetadata, "=")
+			if info.GetMetadata()[key] != value || info.GetMetadata()["method"] != tt.method {
+				t.Errorf("metadata %v, want %v and the method", info.GetMetadata(), tt.wantMetadata)
+			}
+		})
+	}
+}
+
+// tokenContext returns the incoming context of a call with the given authorization metadata, none if empty
+func tokenContext(authorization string) context.Context {
+	if authorization == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+}
+
+// TestAuthenticate signs tokens with Sign and checks the code, reason and claims authenticate returns
+func TestAuthenticate(t *testing.T) {
+	keys := newTestKeys(t)
+	dir := t.TempDir()
+	policy := Policy{
+		"/greet.v1.GreetService/*":        {Public: true},
+		"/blog.v1.BlogService/*":          {Scopes: []string{"blog.read"}},
+		"/blog.v1.BlogService/DeleteBlog": {Roles: []string{"admin"}},
+	}
+	authenticator := func(t *testing.T, keyOpts KeyOptions) *Authenticator {
+		t.Helper()
+		a, err := NewAuthenticator(Options{Keys: keyOpts, Issuer: "issuer", Audience: "blog", Policy: policy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	hmacAuth := authenticator(t, KeyOptions{HMACSecretFile: keys.hmacFile})
+	jwksAuth := authenticator(t, KeyOptions{
+		// ec2 has no kid, it is tried for tokens of any kid
+		JWKSFile: writeJWKS(t, dir, "keys.json", rsaJWK("rsa-1", &keys.rsa.PublicKey), ecJWK("ec-1", &keys.ec.PublicKey),
+			ecJWK("", &keys.ec2.PublicKey)),
+	})
+	kidOnlyAuth := authenticator(t, KeyOptions{JWKSFile: writeJWKS(t, dir, "kid.json", ecJWK("ec-1", &keys.ec.PublicKey))})
+	certAuth := authenticator(t, KeyOptions{PublicKeyFiles: []string{writeCertificate(t, dir, "ec.crt", keys.ec)}})
+
+	reader := func() *Claims {
+		return NewClaims("alice", "issuer", "blog", time.Hour, []string{"blog.read"}, nil)
+	}
+	sign := func(claims *Claims, keyFile, kid string, hmac bool) string {
+		token, err := Sign(claims, keyFile, kid, hmac)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Bearer " + token
+	}
+	tests := []struct {
+		name          string
+		auth          *Authenticator
+		method        string
+		authorization string
+		wantCode      codes.Code
+		wantReason    string
+		wantSubject   string // subject of the claims in the context, none if no claims
+	}{
+		{"hmac", hmacAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.hmacFile, "", true), codes.OK, "", "alice"},
+		{"scheme case", hmacAuth, "/blog.v1.BlogService/ReadBlog", strings.Replace(sign(reader(), keys.hmacFile, "", true), "Bearer", "bearer", 1),
+			codes.OK, "", "alice"},
+		{"jwks kid rsa", jwksAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.rsaFile, "rsa-1", false), codes.OK, "", "alice"},
+		{"jwks kid ec", jwksAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ecFile, "ec-1", false), codes.OK, "", "alice"},
+		{"jwks key without kid", jwksAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ec2File, "", false), codes.OK, "", "alice"},
+		{"jwks unknown kid tries keys without kid", jwksAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ec2File, "ec-2", false),
+			codes.OK, "", "alice"},
+		{"jwks kid of another key", jwksAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ec2File, "ec-1", false),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"jwks unknown kid", kidOnlyAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ecFile, "ec-9", false),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"certificate", certAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ecFile, "", false), codes.OK, "", "alice"},
+		{"certificate of another key", certAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.ec2File, "", false),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"signing method without key", certAuth, "/blog.v1.BlogService/ReadBlog", sign(reader(), keys.hmacFile, "", true),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"no token", hmacAuth, "/blog.v1.BlogService/ReadBlog", "", codes.Unauthenticated, rpcerr.ReasonMissingToken, ""},
+		{"not a bearer token", hmacAuth, "/blog.v1.BlogService/ReadBlog", "Basic YWxpY2U6cHc=", codes.Unauthenticated, rpcerr.ReasonMissingToken, ""},
+		{"malformed token", hmacAuth, "/blog.v1.BlogService/ReadBlog", "Bearer token", codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"expired", hmacAuth, "/blog.v1.BlogService/ReadBlog",
+			sign(NewClaims("alice", "issuer", "blog", -time.Hour, []string{"blog.read"}, nil), keys.hmacFile, "", true),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"expired within leeway", hmacAuth, "/blog.v1.BlogService/ReadBlog",
+			sign(NewClaims("alice", "issuer", "blog", -leeway/2, []string{"blog.read"}, nil), keys.hmacFile, "", true),
+			codes.OK, "", "alice"},
+		{"other issuer", hmacAuth, "/blog.v1.BlogService/ReadBlog",
+			sign(NewClaims("alice", "other", "blog", time.Hour, []string{"blog.read"}, nil), keys.hmacFile, "", true),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"other audience", hmacAuth, "/blog.v1.BlogService/ReadBlog",
+			sign(NewClaims("alice", "issuer", "shop", time.Hour, []string{"blog.read"}, nil), keys.hmacFile, "", true),
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+		{"missing scope", hmacAuth, "/blog.v1.BlogService/ReadBlog",
+			sign(NewClaims("alice", "issuer", "blog", time.Hour, nil, nil), keys.hmacFile, "", true),
+			codes.PermissionDenied, rpcerr.ReasonMissingScope, ""},
+		{"missing role", hmacAuth, "/blog.v1.BlogService/DeleteBlog", sign(reader(), keys.hmacFile, "", true),
+			codes.PermissionDenied, rpcerr.ReasonMissingRole, ""},
+		{"public without token", hmacAuth, "/greet.v1.GreetService/Greet", "", codes.OK, "", ""},
+		{"public with token", hmacAuth, "/greet.v1.GreetService/Greet", sign(reader(), keys.hmacFile, "", true), codes.OK, "", "alice"},
+		{"public with invalid token", hmacAuth, "/greet.v1.GreetService/Greet", "Bearer token",
+			codes.Unauthenticated, rpcerr.ReasonInvalidToken, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := tt.auth.authenticate(tokenContext(tt.authorization), tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("authenticate: %v, want %v", err, tt.wantCode)
+			}
+			if err != nil {
+				if reason := rpcerr.Reason(err); reason != tt.wantReason {
+					t.Errorf("reason %q, want %v", reason, tt.wantReason)
+				}
+				return
+			}
+			claims, ok := ClaimsFromContext(ctx)
+			if tt.wantSubject == "" {
+				if ok {
+					t.Errorf("claims of %v in the context, want none", claims.Subject)
+				}
+				return
+			}
+			if !ok || claims.Subject != tt.wantSubject {
+				t.Errorf("claims %+v in the context, want subject %v", claims, tt.wantSubject)
+			}
+		})
+	}
+}
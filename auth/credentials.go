@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenCredentials attaches a bearer token to every call, it implements credentials.PerRPCCredentials
+type TokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// NewTokenCredentials returns credentials sending token
+// with secure set, grpc refuses to send the token over a connection without TLS
+func NewTokenCredentials(token string, secure bool) *TokenCredentials {
+	return &TokenCredentials{token: strings.TrimSpace(token), secure: secure}
+}
+
+// TokenFromFile reads a token written by the token command
+func TokenFromFile(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("error while reading token: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// GetRequestMetadata returns the authorization metadata of a call
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity reports whether the token may only travel over TLS
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// Sign creates a token for claims with the HMAC secret or the PEM private key in keyFile
+// kid, if set, is put in the header so servers with a JWKS pick the right key
+func Sign(claims *Claims, keyFile, kid string, hmac bool) (string, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", fmt.Errorf("error while reading signing key: %v", err)
+	}
+
+	var token *jwt.Token
+	var key interface{}
+	if hmac {
+		token = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		key = []byte(strings.TrimRight(string(data), "\r\n"))
+	} else if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, claims), rsaKey
+	} else if ecKey, err := jwt.ParseECPrivateKeyFromPEM(data); err == nil {
+		method := jwt.SigningMethodES256
+		switch ecKey.Curve.Params().BitSize {
+		case 384:
+			method = jwt.SigningMethodES384
+		case 521:
+			method = jwt.SigningMethodES512
+		}
+		token, key = jwt.NewWithClaims(method, claims), ecKey
+	} else {
+		return "", fmt.Errorf("%v holds no RSA or ECDSA private key", keyFile)
+	}
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	return token.SignedString(key)
+}
+
+// NewClaims returns claims for subject valid for ttl from now
+func NewClaims(subject, issuer, audience string, ttl time.Duration, scopes, roles []string) *Claims {
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Scope: strings.Join(scopes, " "),
+		Roles: roles,
+	}
+	if audience != "" {
+		claims.Audience = jwt.ClaimStrings{audience}
+	}
+	return claims
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// leeway tolerates clock differences between the token issuer and the server
+const leeway = 30 * time.Second
+
+// Options configures an Authenticator
+type Options struct {
+	Keys     KeyOptions
+	Issuer   string // required iss claim, not checked if empty
+	Audience string // required aud claim, not checked if empty
+	Policy   Policy
+}
+
+// Authenticator verifies bearer tokens and applies the policy in grpc interceptors
+type Authenticator struct {
+	keys   *KeySet
+	parser *jwt.Parser
+	policy Policy
+}
+
+// NewAuthenticator loads the keys of opts
+func NewAuthenticator(opts Options) (*Authenticator, error) {
+	keys, err := LoadKeys(opts.Keys)
+	if err != nil {
+		return nil, err
+	}
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(keys.methods()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+	return &Authenticator{keys: keys, parser: jwt.NewParser(parserOpts...), policy: opts.Policy}, nil
+}
+
+// authenticate returns ctx with the claims of the caller, or an UNAUTHENTICATED or PERMISSION_DENIED error
+func (a *Authenticator) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	token, found := bearerToken(ctx)
+	if !found {
+		if a.policy.rule(fullMethod).Public {
+			return ctx, nil
+		}
+		return nil, rpcerr.Unauthenticated(rpcerr.ReasonMissingToken, "missing bearer token in authorization metadata")
+	}
+
+	// a token given to a public method must still be valid, the handler may rely on the claims
+	claims := &Claims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keys.keyFunc); err != nil {
+		// the parser error tells why the token was rejected, the caller only learns that it was
+		slog.WarnContext(ctx, "rejected invalid bearer token", "method", fullMethod, "error", err)
+		return nil, rpcerr.Unauthenticated(rpcerr.ReasonInvalidToken, "invalid bearer token")
+	}
+	if err := a.policy.authorize(fullMethod, claims); err != nil {
+		return nil, err
+	}
+	return NewContext(ctx, claims), nil
+}
+
+// bearerToken returns the token of the authorization metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+// UnaryServerInterceptor authenticates and authorizes unary calls
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates and authorizes streaming calls before the handler runs
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream replaces the context of a server stream so handlers see the claims
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
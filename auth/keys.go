@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeyOptions lists where the verification keys are read from, at least one must be set
+type KeyOptions struct {
+	HMACSecretFile string   // shared secret for HS256/HS384/HS512 tokens
+	PublicKeyFiles []string // PEM RSA or ECDSA public keys or certificates for RS*, PS* and ES* tokens
+	JWKSFile       string   // local JSON Web Key Set, keys are matched on the kid header
+}
+
+// KeySet holds the keys verifying token signatures
+type KeySet struct {
+	hmac []byte
+	// asymmetric keys by kid, keys from PEM files have no kid and are tried for any token
+	byID      map[string]crypto.PublicKey
+	anonymous []crypto.PublicKey
+}
+
+// LoadKeys reads all keys configured in opts
+func LoadKeys(opts KeyOptions) (*KeySet, error) {
+	keys := &KeySet{byID: map[string]crypto.PublicKey{}}
+	if opts.HMACSecretFile != "" {
+		secret, err := os.ReadFile(opts.HMACSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("error while reading HMAC secret: %v", err)
+		}
+		// a trailing newline added by an editor is not part of the secret
+		keys.hmac = []byte(strings.TrimRight(string(secret), "\r\n"))
+		if len(keys.hmac) < 32 {
+			return nil, fmt.Errorf("HMAC secret in %v must be at least 32 bytes", opts.HMACSecretFile)
+		}
+	}
+	for _, file := range opts.PublicKeyFiles {
+		key, err := readPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		keys.anonymous = append(keys.anonymous, key)
+	}
+	if opts.JWKSFile != "" {
+		if err := keys.loadJWKS(opts.JWKSFile); err != nil {
+			return nil, err
+		}
+	}
+	if keys.hmac == nil && len(keys.anonymous) == 0 && len(keys.byID) == 0 {
+		return nil, fmt.Errorf("no token verification key configured")
+	}
+	return keys, nil
+}
+
+// methods returns the signing algorithms the keys can verify, tokens signed otherwise are rejected
+func (k *KeySet) methods() []string {
+	var methods []string
+	if k.hmac != nil {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	var hasRSA, hasEC bool
+	for _, key := range k.asymmetric() {
+		switch key.(type) {
+		case *rsa.PublicKey:
+			hasRSA = true
+		case *ecdsa.PublicKey:
+			hasEC = true
+		}
+	}
+	if hasRSA {
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512")
+	}
+	if hasEC {
+		methods = append(methods, "ES256", "ES384", "ES512")
+	}
+	return methods
+}
+
+func (k *KeySet) asymmetric() []crypto.PublicKey {
+	keys := append([]crypto.PublicKey{}, k.anonymous...)
+	for _, key := range k.byID {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// keyFunc selects the keys that may have signed token
+func (k *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return k.hmac, nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+	default:
+		return nil, fmt.Errorf("unsupported signing method %v", token.Method.Alg())
+	}
+
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		if key, ok := k.byID[kid]; ok {
+			return key, nil
+		}
+	}
+	// the parser tries every key of the set, the ones of the wrong type fail fast
+	set := jwt.VerificationKeySet{}
+	for _, key := range k.anonymous {
+		set.Keys = append(set.Keys, key)
+	}
+	if len(set.Keys) == 0 {
+		return nil, fmt.Errorf("unknown key id %v", token.Header["kid"])
+	}
+	return set, nil
+}
+
+// jwk is one key of a JSON Web Key Set (RFC 7517), only the members needed for verification
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// loadJWKS adds the RSA and EC signature keys of a JWKS file
+func (k *KeySet) loadJWKS(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error while reading JWKS: %v", err)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("cannot parse JWKS %v: %v", file, err)
+	}
+	for i, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		pub, err := key.publicKey()
+		if err != nil {
+			return fmt.Errorf("JWKS %v key %v: %v", file, i, err)
+		}
+		if key.Kid == "" {
+			k.anonymous = append(k.anonymous, pub)
+			continue
+		}
+		k.byID[key.Kid] = pub
+	}
+	return nil
+}
+
+func (key jwk) publicKey() (crypto.PublicKey, error) {
+	switch key.Kty {
+	case "RSA":
+		n, err := decodeBigInt(key.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(key.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch key.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", key.Crv)
+		}
+		x, err := decodeBigInt(key.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(key.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %v", key.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", key.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid base64url number %q", s)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// readPublicKey reads a PEM public key or the key of a PEM certificate
+func readPublicKey(file string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading public key: %v", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %v", file)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in %v: %v", file, err)
+		}
+		key = cert.PublicKey
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key in %v: %v", file, err)
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T in %v", key, file)
+	}
+}
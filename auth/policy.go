@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// Rule is the authorization rule of a method
+type Rule struct {
+	Public bool     // callable without a token
+	Scopes []string // the caller needs every one of these scopes
+	Roles  []string // the caller needs at least one of these roles, any role if empty
+}
+
+// Policy maps full method names to rules.
+// Keys are either a full method, e.g. /blog.v1.BlogService/DeleteBlog, or all methods of a service, e.g. /blog.v1.BlogService/*.
+// Methods without a rule need an authenticated caller and nothing else.
+type Policy map[string]Rule
+
+// rule returns the rule of fullMethod, preferring an exact match over the service wildcard
+func (p Policy) rule(fullMethod string) Rule {
+	if rule, ok := p[fullMethod]; ok {
+		return rule
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		if rule, ok := p[fullMethod[:i+1]+"*"]; ok {
+			return rule
+		}
+	}
+	return Rule{}
+}
+
+// authorize checks the claims of the caller against the rule of fullMethod
+func (p Policy) authorize(fullMethod string, claims *Claims) error {
+	rule := p.rule(fullMethod)
+	for _, scope := range rule.Scopes {
+		if !claims.HasScope(scope) {
+			return rpcerr.PermissionDenied(rpcerr.ReasonMissingScope,
+				"missing scope "+scope+" to call "+fullMethod, fullMethod,
+				map[string]string{"scope": scope})
+		}
+	}
+	if len(rule.Roles) == 0 {
+		return nil
+	}
+	for _, role := range rule.Roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return rpcerr.PermissionDenied(rpcerr.ReasonMissingRole,
+		"one of the roles "+strings.Join(rule.Roles, ", ")+" is needed to call "+fullMethod, fullMethod,
+		map[string]string{"roles": strings.Join(rule.Roles, ",")})
+}
@@ -0,0 +1,78 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	mongo "go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// resource type reported in ResourceInfo for a missing blog
+const blogResourceType = "blog.Blog"
+
+// storeRetryDelay is the delay suggested to clients after a transient store failure
+const storeRetryDelay = 2 * time.Second
+
+// invalidBlogID is returned when a blog ID cannot be parsed as ObjectID
+func invalidBlogID(field string, err error) error {
+	return rpcerr.InvalidArgument(
+		rpcerr.ReasonInvalidID,
+		fmt.Sprintf("Cannot parse blog ID: %v", err),
+		rpcerr.FieldViolation(field, "must be a 24 character hex object id"),
+	)
+}
+
+// blogNotFound is returned when no blog exists for the given blogID
+func blogNotFound(blogID string) error {
+	return rpcerr.NotFound(blogResourceType, blogID, fmt.Sprintf("Cannot find blog with ID: %v", blogID))
+}
+
+// slugNotFound is returned when no blog has the given slug
+func slugNotFound(slug string) error {
+	return rpcerr.NotFound(blogResourceType, slug, fmt.Sprintf("Cannot find blog with slug: %v", slug))
+}
+
+// slugTaken is returned when another blog has the slug of a created or updated blog
+func slugTaken(slug string) error {
+	return rpcerr.AlreadyExists(blogResourceType, slug, fmt.Sprintf("Another blog has the slug %q", slug))
+}
+
+// versionMismatch is returned when a change is based on another version than the current one of the blog
+func versionMismatch(blogID string, version, current int64) error {
+	return rpcerr.Aborted(
+		rpcerr.ReasonVersionMismatch,
+		fmt.Sprintf("Blog with ID %v is at version %d, not %d", blogID, current, version),
+		map[string]string{"current_version": strconv.FormatInt(current, 10)},
+	)
+}
+
+// storeError converts an error from mongodb into a gRPC error
+// operations stopped because the call was canceled or ran out of time report that,
+// network errors and timeouts are transient and reported as UNAVAILABLE with RetryInfo
+// everything else is reported as INTERNAL
+func storeError(msg string, err error) error {
+	// before IsTimeout, which also matches an expired deadline of the call
+	switch {
+	case errors.Is(err, context.Canceled):
+		return rpcerr.Canceled(fmt.Sprintf("%v: %v", msg, err))
+	case errors.Is(err, context.DeadlineExceeded):
+		return rpcerr.DeadlineExceeded(fmt.Sprintf("%v: %v", msg, err))
+	}
+	if mongo.IsNetworkError(err) || mongo.IsTimeout(err) || errors.Is(err, mongo.ErrClientDisconnected) {
+		return rpcerr.Transient(rpcerr.ReasonStoreTransient, fmt.Sprintf("%v: %v", msg, err), storeRetryDelay)
+	}
+	return rpcerr.Internal(rpcerr.ReasonStoreFailure, fmt.Sprintf("%v: %v", msg, err))
+}
+
+// findError converts the error of a FindOne into NOT_FOUND for a missing blog or a store error otherwise
+func findError(blogID string, err error) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return blogNotFound(blogID)
+	}
+	return storeError("Cannot read blog from mongodb", err)
+}
@@ -0,0 +1,313 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// migrationCollection records the versions of the applied migrations, one document per migration
+const migrationCollection = "schema_migrations"
+
+// migrationLockCollection holds the lock of the server applying migrations, so that the others wait for it
+const migrationLockCollection = "schema_migrations_lock"
+
+// settings of the migration lock
+const (
+	migrationLockID   = "blog"
+	migrationLease    = time.Minute // the lock of a server that crashed is taken over after this
+	migrationLockPoll = time.Second // how often a waiting server checks the lock
+)
+
+// errLeaseLost is the cause of the migration context when the migration lock could not be renewed
+var errLeaseLost = errors.New("the migration lock was lost")
+
+// viewBucketTTL is how long hourly view buckets are kept, a bit longer than the week window
+const viewBucketTTL = 8 * 24 * time.Hour
+
+// migration is one forward-only schema change of the blog store
+// versions must be unique and never reused, a released migration must never be edited
+// up must be idempotent: a server stopped between up and recording the migration applies it again
+type migration struct {
+	version     int
+	description string
+	up          func(ctx context.Context, db *mongo.Database, names Collections) error
+}
+
+// data-model object for an applied migration
+type migrationRecord struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+// migrations are all schema changes of the blog store in the order they are applied
+var migrations = []migration{
+	{
+		version:     1,
+		description: "create author_id index on blogs",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			_, err := db.Collection(names.Blogs).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "author_id", Value: 1}},
+				Options: options.Index().SetName("author_id"),
+			})
+			return err
+		},
+	},
+	{
+		version:     2,
+		description: "backfill view_count of existing blogs",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			_, err := db.Collection(names.Blogs).UpdateMany(ctx,
+				bson.M{"view_count": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"view_count": int64(0)}},
+			)
+			return err
+		},
+	},
+	{
+		version:     3,
+		description: "create view_count index on blogs and indexes on views",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			_, err := db.Collection(names.Blogs).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "view_count", Value: -1}},
+				Options: options.Index().SetName("view_count"),
+			})
+			if err != nil {
+				return err
+			}
+			_, err = db.Collection(names.Views).Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "blog_id", Value: 1}, {Key: "hour", Value: 1}},
+					Options: options.Index().SetName("blog_id_hour").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "hour", Value: 1}},
+					Options: options.Index().SetName("hour_ttl").SetExpireAfterSeconds(int32(viewBucketTTL.Seconds())),
+				},
+			})
+			return err
+		},
+	},
+	{
+		version:     4,
+		description: "backfill state, version and times of existing blogs for blog.v2",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			// existing blogs were all public, they are published since their creation, the time of their ObjectID
+			created := bson.M{"$toDate": "$_id"}
+			_, err := db.Collection(names.Blogs).UpdateMany(ctx,
+				bson.M{"state": bson.M{"$exists": false}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.M{
+					"state":        statePublished,
+					"version":      int64(1),
+					"create_time":  created,
+					"update_time":  created,
+					"publish_time": created,
+				}}}},
+			)
+			return err
+		},
+	},
+	{
+		version:     5,
+		description: "create update_time index on blogs",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			_, err := db.Collection(names.Blogs).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "update_time", Value: -1}},
+				Options: options.Index().SetName("update_time"),
+			})
+			return err
+		},
+	},
+	{
+		version:     6,
+		description: "create unique slug index on blogs",
+		up: func(ctx context.Context, db *mongo.Database, names Collections) error {
+			// blogs without a slug have no slug field and are left out of the index
+			_, err := db.Collection(names.Blogs).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "slug", Value: 1}},
+				Options: options.Index().SetName("slug").SetUnique(true).
+					SetPartialFilterExpression(bson.M{"slug": bson.M{"$type": "string"}}),
+			})
+			return err
+		},
+	},
+}
+
+// migrate applies all migrations not yet recorded in the store, in version order
+// it holds the migration lock meanwhile, so concurrent servers wait until the schema is migrated
+// a migration is recorded once applied; if one fails, or the lock is lost, the remaining migrations are not applied
+// it returns the migrations applied by this call
+func migrate(ctx context.Context, db *mongo.Database, names Collections) ([]migration, error) {
+	ctx, unlock, err := lockMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	records := db.Collection(migrationCollection)
+	applied, err := appliedMigrations(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	record := func(ctx context.Context, m migration) error {
+		_, err := records.InsertOne(ctx, migrationRecord{Version: m.version, Description: m.description, AppliedAt: time.Now().UTC()})
+		return err
+	}
+	return applyMigrations(ctx, pendingMigrations(migrations, applied), db, names, record)
+}
+
+// pendingMigrations returns the migrations of all whose version is not applied, in version order
+func pendingMigrations(all []migration, applied map[int]bool) []migration {
+	pending := make([]migration, 0, len(all))
+	for _, m := range all {
+		if !applied[m.version] {
+			pending = append(pending, m)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].version < pending[j].version })
+	return pending
+}
+
+// applyMigrations applies and records the pending migrations in order, stopping at the first failure
+// or once ctx is done, e.g. because the migration lock was lost; it returns the migrations applied
+func applyMigrations(ctx context.Context, pending []migration, db *mongo.Database, names Collections,
+	record func(ctx context.Context, m migration) error) ([]migration, error) {
+	var done []migration
+	for _, m := range pending {
+		if ctx.Err() != nil {
+			return done, fmt.Errorf("migration %d (%v) not applied: %v", m.version, m.description, context.Cause(ctx))
+		}
+		if err := m.up(ctx, db, names); err != nil {
+			if ctx.Err() != nil {
+				err = context.Cause(ctx)
+			}
+			return done, fmt.Errorf("migration %d (%v) failed: %v", m.version, m.description, err)
+		}
+		if err := record(ctx, m); err != nil {
+			return done, fmt.Errorf("migration %d (%v) was applied but cannot be recorded, it is applied again next time: %v",
+				m.version, m.description, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// lockMigrations takes the migration lock of the store, waiting while another server holds it
+// the lock is a lease renewed until unlock is called, a server that crashed holds it for migrationLease at most
+// the returned context is cancelled with errLeaseLost if a renewal fails, migrations must stop then
+// as another server may take the lock over
+func lockMigrations(ctx context.Context, db *mongo.Database) (lockCtx context.Context, unlock func(), err error) {
+	locks := db.Collection(migrationLockCollection)
+	owner := primitive.NewObjectID().Hex()
+	lease := func(ctx context.Context, filter bson.M, upsert bool) (*mongo.UpdateResult, error) {
+		now := time.Now().UTC()
+		return locks.UpdateOne(ctx, filter,
+			bson.M{"$set": bson.M{"owner": owner, "expires_at": now.Add(migrationLease)}},
+			options.Update().SetUpsert(upsert))
+	}
+
+	waiting := false
+	for {
+		// a lock held by another server does not match, and the upsert fails on its _id
+		_, err := lease(ctx, bson.M{"_id": migrationLockID, "expires_at": bson.M{"$lt": time.Now().UTC()}}, true)
+		if err == nil {
+			break
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, nil, fmt.Errorf("cannot take the migration lock: %v", err)
+		}
+		if !waiting {
+			waiting = true
+			slog.Info("waiting for another server to finish the migrations")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("gave up waiting for the migration lock: %v", ctx.Err())
+		case <-time.After(migrationLockPoll):
+		}
+	}
+
+	// renew the lease while migrating, long migrations must not be taken over
+	lockCtx, lost := context.WithCancelCause(ctx)
+	stop := make(chan struct{})
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(migrationLease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				res, err := lease(ctx, bson.M{"_id": migrationLockID, "owner": owner}, false)
+				if err == nil && res.MatchedCount == 0 {
+					err = fmt.Errorf("the lock was taken over")
+				}
+				if err != nil {
+					slog.Error("cannot renew the migration lock, stopping the migrations", "error", err)
+					lost(fmt.Errorf("%w: %v", errLeaseLost, err))
+					return
+				}
+			}
+		}
+	}()
+
+	return lockCtx, func() {
+		close(stop)
+		<-renewed
+		lost(nil)
+		// the migration context may be done already
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := locks.DeleteOne(releaseCtx, bson.M{"_id": migrationLockID, "owner": owner}); err != nil {
+			slog.Error("cannot release the migration lock, it expires by itself", "error", err)
+		}
+	}, nil
+}
+
+// appliedMigrations returns the versions recorded in the store
+func appliedMigrations(ctx context.Context, records *mongo.Collection) (map[int]bool, error) {
+	cur, err := records.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot read applied migrations: %v", err)
+	}
+	var applied []migrationRecord
+	if err := cur.All(ctx, &applied); err != nil {
+		return nil, fmt.Errorf("cannot read applied migrations: %v", err)
+	}
+
+	versions := make(map[int]bool, len(applied))
+	for _, record := range applied {
+		versions[record.Version] = true
+	}
+	return versions, nil
+}
+
+// Migrate applies pending schema migrations to the blog database and logs what was done
+func Migrate(db *mongo.Database, names Collections) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	done, err := migrate(ctx, db, names)
+	for _, m := range done {
+		slog.Info("applied migration", "version", m.version, "description", m.description)
+	}
+	if err != nil {
+		return err
+	}
+	if len(done) == 0 {
+		slog.Info("blog store schema is up to date")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	mongo "go.mongodb.org/mongo-driver/mongo"
+)
+
+// testMigrations returns migrations of the given versions whose up appends the version to applied
+// and fails for the version fail
+func testMigrations(applied *[]int, fail int, versions ...int) []migration {
+	var ms []migration
+	for _, version := range versions {
+		version := version
+		ms = append(ms, migration{version: version, description: "test", up: func(context.Context, *mongo.Database, Collections) error {
+			if version == fail {
+				return errors.New("index build failed")
+			}
+			*applied = append(*applied, version)
+			return nil
+		}})
+	}
+	return ms
+}
+
+// migrationVersions returns the versions of ms in order
+func migrationVersions(ms []migration) []int {
+	var versions []int
+	for _, m := range ms {
+		versions = append(versions, m.version)
+	}
+	return versions
+}
+
+func TestMigrationVersions(t *testing.T) {
+	for i, m := range migrations {
+		if m.version != i+1 {
+			t.Errorf("migration %d has version %d, versions must follow each other from 1", i, m.version)
+		}
+		if m.description == "" || m.up == nil {
+			t.Errorf("migration %d has no description or up", m.version)
+		}
+	}
+}
+
+func TestPendingMigrations(t *testing.T) {
+	var applied []int
+	all := testMigrations(&applied, 0, 3, 1, 4, 2)
+	tests := []struct {
+		name    string
+		applied map[int]bool
+		want    []int
+	}{
+		{"new store", map[int]bool{}, []int{1, 2, 3, 4}},
+		{"up to date", map[int]bool{1: true, 2: true, 3: true, 4: true}, nil},
+		{"new migrations", map[int]bool{1: true, 2: true}, []int{3, 4}},
+		{"gap left by a failed record", map[int]bool{1: true, 3: true}, []int{2, 4}},
+		{"unknown versions of a newer server", map[int]bool{1: true, 2: true, 7: true}, []int{3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := migrationVersions(pendingMigrations(all, tt.applied)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pending migrations %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyMigrations(t *testing.T) {
+	recordErr := errors.New("connection reset by peer")
+	tests := []struct {
+		name         string
+		fail         int // version whose up fails
+		failRecord   int // version that cannot be recorded
+		wantApplied  []int
+		wantRecorded []int
+		wantErr      string
+	}{
+		{"all", 0, 0, []int{1, 2, 3}, []int{1, 2, 3}, ""},
+		{"stops at a failed migration", 2, 0, []int{1}, []int{1}, "migration 2 (test) failed: index build failed"},
+		{"stops at a failed record", 0, 2, []int{1, 2}, []int{1}, "migration 2 (test) was applied but cannot be recorded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied, recorded []int
+			record := func(ctx context.Context, m migration) error {
+				if m.version == tt.failRecord {
+					return recordErr
+				}
+				recorded = append(recorded, m.version)
+				return nil
+			}
+			done, err := applyMigrations(context.Background(), testMigrations(&applied, tt.fail, 1, 2, 3), nil, Collections{}, record)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("applyMigrations error %v, want %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(applied, tt.wantApplied) {
+				t.Errorf("applied %v, want %v", applied, tt.wantApplied)
+			}
+			if !reflect.DeepEqual(recorded, tt.wantRecorded) || !reflect.DeepEqual(migrationVersions(done), tt.wantRecorded) {
+				t.Errorf("recorded %v and returned %v, want %v", recorded, migrationVersions(done), tt.wantRecorded)
+			}
+		})
+	}
+}
+
+// TestApplyMigrationsLeaseLost checks that no migration is applied once the lock is lost
+func TestApplyMigrationsLeaseLost(t *testing.T) {
+	ctx, lost := context.WithCancelCause(context.Background())
+	var applied []int
+	ms := testMigrations(&applied, 0, 1, 2, 3)
+	first := ms[0].up
+	ms[0].up = func(ctx context.Context, db *mongo.Database, names Collections) error {
+		lost(errLeaseLost)
+		return first(ctx, db, names)
+	}
+	record := func(context.Context, migration) error { return nil }
+
+	done, err := applyMigrations(ctx, ms, nil, Collections{}, record)
+	if !reflect.DeepEqual(applied, []int{1}) || !reflect.DeepEqual(migrationVersions(done), []int{1}) {
+		t.Errorf("applied %v and returned %v after the lock was lost, want [1]", applied, migrationVersions(done))
+	}
+	if err == nil || !strings.Contains(err.Error(), errLeaseLost.Error()) {
+		t.Errorf("applyMigrations error %v, want %v", err, errLeaseLost)
+	}
+}
@@ -0,0 +1,351 @@
+package blogservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// settings of the related-posts recommendation
+const (
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 50
+
+	contentWeight = 0.6  // weight of the TF-IDF cosine similarity of title and content
+	tagWeight     = 0.25 // weight of the overlap of the tags, shared tags over all tags of both posts
+	authorWeight  = 0.15 // bonus for posts written by the same author
+
+	// relatedMaxAge is how long the index is used before it is rebuilt, so that blogs created, changed,
+	// unpublished or deleted through other servers sharing the store show up in the recommendations
+	relatedMaxAge = time.Minute
+)
+
+// stopWords are left out of the content vectors as they carry no meaning
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true,
+	"for": true, "from": true, "has": true, "in": true, "is": true, "it": true, "of": true, "on": true,
+	"or": true, "that": true, "the": true, "this": true, "to": true, "was": true, "with": true,
+}
+
+// relatedDoc is one blog of the index with its normalized TF-IDF vector and its set of tags
+type relatedDoc struct {
+	item   *blogItem
+	vector map[string]float64
+	tags   map[string]bool
+}
+
+// relatedScore is a blog similar to another one with its similarity score
+type relatedScore struct {
+	doc   *relatedDoc
+	score float64
+}
+
+// relatedIndex computes similar blogs from the blog store without any external service
+// the index and the per-blog results are cached until invalidate is called or for up to relatedMaxAge,
+// so view counts of the returned blogs are as of the last rebuild
+type relatedIndex struct {
+	collection *mongo.Collection  // blogs the index is built from
+	flight     singleflight.Group // one rebuild at a time, shared by the calls waiting for it
+
+	mu         sync.Mutex
+	generation int              // incremented on every invalidate
+	current    *relatedSnapshot // nil until built or after invalidate
+}
+
+// relatedSnapshot is the index built from the store at one time, with the rankings computed from it
+type relatedSnapshot struct {
+	built   time.Time
+	docs    map[primitive.ObjectID]*relatedDoc
+	results map[primitive.ObjectID][]relatedScore // up to maxRelatedLimit each, guarded by relatedIndex.mu
+}
+
+// invalidate drops the cached index, it is rebuilt from the store on the next request
+func (r *relatedIndex) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	r.current = nil
+}
+
+// cached returns the current snapshot, nil if there is none or it is older than relatedMaxAge
+// r.mu must be held
+func (r *relatedIndex) cached() *relatedSnapshot {
+	if r.current == nil || time.Since(r.current.built) > relatedMaxAge {
+		return nil
+	}
+	return r.current
+}
+
+// index returns the current snapshot, building it from the store if needed
+// calls arriving while the index is built wait for that build instead of scanning the store again
+func (r *relatedIndex) index(ctx context.Context) (*relatedSnapshot, error) {
+	r.mu.Lock()
+	snapshot := r.cached()
+	r.mu.Unlock()
+	if snapshot != nil {
+		return snapshot, nil
+	}
+
+	built := r.flight.DoChan("index", func() (interface{}, error) {
+		return r.build(ctx)
+	})
+	select {
+	case res := <-built:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*relatedSnapshot), nil
+	case <-ctx.Done():
+		return nil, rpcerr.Context(ctx, "GetRelatedBlogs was stopped")
+	}
+}
+
+// build loads a snapshot from the store and makes it current
+func (r *relatedIndex) build(ctx context.Context) (*relatedSnapshot, error) {
+	r.mu.Lock()
+	generation := r.generation
+	r.mu.Unlock()
+
+	snapshot := &relatedSnapshot{built: time.Now(), results: map[primitive.ObjectID][]relatedScore{}}
+	docs, err := buildRelatedDocs(ctx, r.collection)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.docs = docs
+
+	// keep the snapshot only if no blog changed while it was built
+	r.mu.Lock()
+	if r.generation == generation {
+		r.current = snapshot
+	}
+	r.mu.Unlock()
+	return snapshot, nil
+}
+
+// rank returns up to limit blogs most similar to the blog with the given id
+func (r *relatedIndex) rank(ctx context.Context, oid primitive.ObjectID, limit int) ([]relatedScore, error) {
+	snapshot, err := r.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	cached, ok := snapshot.results[oid]
+	r.mu.Unlock()
+	if ok {
+		return firstScores(cached, limit), nil
+	}
+
+	target, ok := snapshot.docs[oid]
+	if !ok {
+		// the blog may have been created or published by another server: rebuild once if it exists,
+		// looking it up first so that unknown ids cannot make every call rebuild the index
+		if err := r.exists(ctx, oid); err != nil {
+			return nil, err
+		}
+		r.invalidate()
+		if snapshot, err = r.index(ctx); err != nil {
+			return nil, err
+		}
+		if target, ok = snapshot.docs[oid]; !ok {
+			return nil, blogNotFound(oid.Hex())
+		}
+	}
+
+	// building the index may have used up the time of the call
+	if err := rpcerr.Context(ctx, "GetRelatedBlogs was stopped"); err != nil {
+		return nil, err
+	}
+
+	scores := rankSimilar(target, snapshot.docs)
+	r.mu.Lock()
+	snapshot.results[oid] = scores
+	r.mu.Unlock()
+	return firstScores(scores, limit), nil
+}
+
+// rankSimilar returns up to maxRelatedLimit docs similar to target, most similar first
+func rankSimilar(target *relatedDoc, docs map[primitive.ObjectID]*relatedDoc) []relatedScore {
+	scores := make([]relatedScore, 0, len(docs))
+	for id, doc := range docs {
+		if id == target.item.ID {
+			continue
+		}
+		if score := similarity(target, doc); score > 0 {
+			scores = append(scores, relatedScore{doc: doc, score: score})
+		}
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].score != scores[j].score {
+			return scores[i].score > scores[j].score
+		}
+		return scores[i].doc.item.ID.Hex() < scores[j].doc.item.ID.Hex()
+	})
+	return firstScores(scores, maxRelatedLimit)
+}
+
+// exists returns NOT_FOUND unless the published blog with the given id is in the store
+func (r *relatedIndex) exists(ctx context.Context, oid primitive.ObjectID) error {
+	opts := options.FindOne().SetProjection(bson.M{"_id": 1})
+	storeCtx, end := storeOp(ctx, r.collection, "findOne")
+	err := r.collection.FindOne(storeCtx, blogFilter(oid, true), opts).Err()
+	end(err)
+	if err != nil {
+		return findError(oid.Hex(), err)
+	}
+	return nil
+}
+
+// firstScores returns at most n scores
+func firstScores(scores []relatedScore, n int) []relatedScore {
+	if len(scores) > n {
+		return scores[:n]
+	}
+	return scores
+}
+
+// similarity scores how related two blogs are, between 0 and 1
+func similarity(a, b *relatedDoc) float64 {
+	score := contentWeight*cosine(a.vector, b.vector) + tagWeight*jaccard(a.tags, b.tags)
+	if a.item.AuthorID != "" && a.item.AuthorID == b.item.AuthorID {
+		score += authorWeight
+	}
+	return score
+}
+
+// cosine returns the cosine similarity of two normalized vectors
+func cosine(a, b map[string]float64) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	dot := 0.0
+	for term, weight := range a {
+		dot += weight * b[term]
+	}
+	return dot
+}
+
+// jaccard returns the number of tags shared by a and b over the number of their distinct tags, 0 if they have none
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	shared := 0
+	for tag := range a {
+		if b[tag] {
+			shared++
+		}
+	}
+	if shared == 0 {
+		return 0
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// tokenize splits text into lower-case terms without stop words
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := words[:0]
+	for _, word := range words {
+		if len(word) > 1 && !stopWords[word] {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// buildRelatedDocs loads all published blogs from mongodb and computes their related docs
+func buildRelatedDocs(ctx context.Context, collection *mongo.Collection) (map[primitive.ObjectID]*relatedDoc, error) {
+	var items []*blogItem
+	storeCtx, end := storeOp(ctx, collection, "find")
+	cur, err := collection.Find(storeCtx, bson.M{"state": publishedState})
+	if err == nil {
+		err = cur.All(storeCtx, &items)
+	}
+	end(err)
+	if err != nil {
+		return nil, storeError("Cannot load blogs from mongodb", err)
+	}
+	return relatedDocs(items), nil
+}
+
+// relatedDocs computes the normalized TF-IDF vectors of items over title and content and their sets of tags
+func relatedDocs(items []*blogItem) map[primitive.ObjectID]*relatedDoc {
+	// term frequencies per blog and document frequency per term
+	termCounts := make([]map[string]float64, len(items))
+	documentFreq := map[string]float64{}
+	for i, item := range items {
+		counts := map[string]float64{}
+		for _, term := range tokenize(item.Title + " " + item.Content) {
+			counts[term]++
+		}
+		for term := range counts {
+			documentFreq[term]++
+		}
+		termCounts[i] = counts
+	}
+
+	// weight terms by tf * smoothed idf and normalize every vector to unit length
+	n := float64(len(items))
+	docs := make(map[primitive.ObjectID]*relatedDoc, len(items))
+	for i, item := range items {
+		vector := make(map[string]float64, len(termCounts[i]))
+		norm := 0.0
+		for term, count := range termCounts[i] {
+			weight := count * (math.Log((1+n)/(1+documentFreq[term])) + 1)
+			vector[term] = weight
+			norm += weight * weight
+		}
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for term := range vector {
+				vector[term] /= norm
+			}
+		}
+		tags := make(map[string]bool, len(item.Tags))
+		for _, tag := range item.Tags {
+			tags[tag] = true
+		}
+		docs[item.ID] = &relatedDoc{item: item, vector: vector, tags: tags}
+	}
+	return docs
+}
+
+// relatedBlogs returns the published blogs most similar to the given blog based on shared tags, same author
+// and TF-IDF cosine similarity of title and content
+// throws NOT_FOUND error if blog is not found in mongodb or not published
+func (s *Server) relatedBlogs(ctx context.Context, blogID string, limit int32) ([]relatedScore, error) {
+	// read blogId from request and parse it as ObjectID
+	oid, err := primitive.ObjectIDFromHex(blogID)
+	if err != nil {
+		return nil, invalidBlogID("blog_id", err)
+	}
+
+	// validate the limit and apply the server default
+	if limit < 0 || limit > maxRelatedLimit {
+		return nil, rpcerr.InvalidArgument(
+			rpcerr.ReasonInvalidArgument,
+			fmt.Sprintf("limit must be between 0 and %d", maxRelatedLimit),
+			rpcerr.FieldViolation("limit", fmt.Sprintf("must be between 0 and %d", maxRelatedLimit)),
+		)
+	}
+	if limit == 0 {
+		limit = defaultRelatedLimit
+	}
+
+	return s.related.rank(ctx, oid, int(limit))
+}
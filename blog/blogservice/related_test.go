@@ -0,0 +1,139 @@
+package blogservice
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{}},
+		{"The Go gRPC examples", []string{"go", "grpc", "examples"}},
+		{"streams, deadlines & retries!", []string{"streams", "deadlines", "retries"}},
+		{"a b c HTTP/2 on port 50051", []string{"http", "port", "50051"}},
+		{"Ünïcode wörds stay", []string{"ünïcode", "wörds", "stay"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCosine(t *testing.T) {
+	half := math.Sqrt(0.5)
+	tests := []struct {
+		name string
+		a, b map[string]float64
+		want float64
+	}{
+		{"same", map[string]float64{"go": 1}, map[string]float64{"go": 1}, 1},
+		{"disjoint", map[string]float64{"go": 1}, map[string]float64{"rust": 1}, 0},
+		{"one shared term of two", map[string]float64{"go": half, "grpc": half}, map[string]float64{"go": 1}, half},
+		{"empty", map[string]float64{}, map[string]float64{"go": 1}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cosine(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("cosine = %v, want %v", got, tt.want)
+			}
+			if got := cosine(tt.b, tt.a); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("cosine swapped = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want float64
+	}{
+		{"same", []string{"go", "grpc"}, []string{"grpc", "go"}, 1},
+		{"none shared", []string{"go"}, []string{"rust"}, 0},
+		{"no tags", nil, nil, 0},
+		{"one of three", []string{"go", "grpc"}, []string{"go", "http"}, 1.0 / 3},
+		{"subset", []string{"go"}, []string{"go", "grpc", "http", "rest"}, 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jaccard(tagSet(tt.a), tagSet(tt.b)); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("jaccard = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	doc := func(author string, vector map[string]float64, tags ...string) *relatedDoc {
+		return &relatedDoc{item: &blogItem{AuthorID: author}, vector: vector, tags: tagSet(tags)}
+	}
+	goVector := map[string]float64{"go": 1}
+	tests := []struct {
+		name string
+		a, b *relatedDoc
+		want float64
+	}{
+		{"identical", doc("rahul", goVector, "go"), doc("rahul", goVector, "go"), contentWeight + tagWeight + authorWeight},
+		{"same content", doc("rahul", goVector), doc("ana", goVector), contentWeight},
+		{"same tags", doc("rahul", nil, "go"), doc("ana", nil, "go"), tagWeight},
+		{"same author", doc("rahul", nil), doc("rahul", nil), authorWeight},
+		{"no author is not the same author", doc("", nil), doc("", nil), 0},
+		{"unrelated", doc("rahul", goVector, "go"), doc("ana", map[string]float64{"rust": 1}, "rust"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("similarity = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRankSimilar checks the order of related blogs: by score, then by id, without unrelated ones
+func TestRankSimilar(t *testing.T) {
+	item := func(id byte, author, title string, tags ...string) *blogItem {
+		return &blogItem{ID: primitive.ObjectID{11: id}, AuthorID: author, Title: title, Tags: tags}
+	}
+	target := item(1, "rahul", "Streaming calls with gRPC in Go", "go", "grpc")
+	items := []*blogItem{
+		target,
+		item(2, "ana", "Streaming gRPC calls", "grpc"),
+		item(3, "rahul", "Baking bread"),
+		item(4, "ana", "Gardening in spring"),
+		item(5, "ana", "Streaming gRPC calls", "grpc"),
+		item(6, "rahul", "Cooking rice"),
+	}
+	docs := relatedDocs(items)
+
+	scores := rankSimilar(docs[target.ID], docs)
+	var got []byte
+	for _, score := range scores {
+		got = append(got, score.doc.item.ID[11])
+	}
+	// 2 and 5 share content and a tag and tie, 3 and 6 only share the author
+	if want := []byte{2, 5, 3, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("related blogs %v, want %v", got, want)
+	}
+	if scores[0].score <= scores[2].score {
+		t.Errorf("shared content and tags score %v, no more than same author %v", scores[0].score, scores[2].score)
+	}
+}
+
+// tagSet returns the set of tags
+func tagSet(tags []string) map[string]bool {
+	set := map[string]bool{}
+	for _, tag := range tags {
+		set[tag] = true
+	}
+	return set
+}
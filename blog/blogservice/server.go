@@ -0,0 +1,385 @@
+// Package blogservice implements the blog.v1 and blog.v2 BlogService gRPC APIs on top of mongodb
+// both APIs serve the same blogs, their messages are translated from and to one data-model, see translate.go
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// Server stores blogs in a mongodb database and serves them with the APIs returned by V1 and V2
+type Server struct {
+	collection     *mongo.Collection // blog documents
+	viewCollection *mongo.Collection // hourly view buckets, one document per blog and hour
+	views          *viewCounter
+	related        *relatedIndex
+
+	stopViews context.CancelFunc
+	viewsDone chan struct{}
+}
+
+// Collections names the mongodb collections of the blog service
+type Collections struct {
+	Blogs string // blog documents
+	Views string // hourly view buckets
+}
+
+// NewServer returns the blog store of the given database
+// Start must be called before serving requests and Close after the grpc server has stopped
+func NewServer(db *mongo.Database, names Collections) *Server {
+	collection := db.Collection(names.Blogs)
+	viewCollection := db.Collection(names.Views)
+	return &Server{
+		collection:     collection,
+		viewCollection: viewCollection,
+		views:          newViewCounter(collection, viewCollection),
+		related:        &relatedIndex{collection: collection},
+	}
+}
+
+// V1 returns the blog.v1 BlogService of the store, which only sees published blogs
+func (s *Server) V1() blogv1.BlogServiceServer {
+	return &v1Server{store: s}
+}
+
+// V2 returns the blog.v2 BlogService of the store
+func (s *Server) V2() blogv2.BlogServiceServer {
+	return &v2Server{store: s}
+}
+
+// Start flushes buffered blog views in background until Close is called
+func (s *Server) Start() {
+	ctx, stop := context.WithCancel(context.Background())
+	s.stopViews = stop
+	s.viewsDone = make(chan struct{})
+	go func() {
+		s.views.run(ctx, viewFlushInterval)
+		close(s.viewsDone)
+	}()
+}
+
+// Close stops the background work and flushes the buffered blog views within ctx
+// the mongodb client must still be connected
+func (s *Server) Close(ctx context.Context) error {
+	if s.stopViews == nil {
+		return nil
+	}
+	s.stopViews()
+	<-s.viewsDone
+	s.stopViews = nil
+	return s.views.flush(ctx)
+}
+
+// states of a blog stored in its state field
+const (
+	stateDraft     = "draft"
+	statePublished = "published"
+	stateArchived  = "archived"
+)
+
+// publishedState matches the state field of published blogs
+// blogs stored before migration 4 have no state, they were all public
+var publishedState = bson.M{"$in": bson.A{statePublished, nil}}
+
+// data-model object for blog, holding the fields of all API versions
+type blogItem struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorID    string             `bson:"author_id"`
+	Content     string             `bson:"content"`
+	Title       string             `bson:"title"`
+	ViewCount   int64              `bson:"view_count"`
+	State       string             `bson:"state"`
+	Version     int64              `bson:"version"` // incremented by every change
+	CreateTime  time.Time          `bson:"create_time"`
+	UpdateTime  time.Time          `bson:"update_time"`
+	PublishTime time.Time          `bson:"publish_time,omitempty"` // first publication, zero before
+	Tags        []string           `bson:"tags,omitempty"`
+	Slug        string             `bson:"slug,omitempty"` // unique, missing if the blog has none
+}
+
+// published returns whether the blog is visible to blog.v1 and in popular and related blogs
+func (b *blogItem) published() bool {
+	return b.State == statePublished || b.State == ""
+}
+
+// blogEdit is a change of the editable fields of a blog requested by a client
+type blogEdit struct {
+	authorID string
+	title    string
+	content  string
+	state    string   // unchanged if empty
+	tags     []string // unchanged if nil, blog.v1 has no tags
+	slug     *string  // unchanged if nil as blog.v1 has no slug, removed if empty
+	version  int64    // version the change is based on, any version if 0
+}
+
+// storeNow returns the current time at the millisecond precision of mongodb,
+// so that returned blogs have the times they are read back with
+func storeNow() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// blogFilter selects the blog with the given id, only if published with publishedOnly
+func blogFilter(oid primitive.ObjectID, publishedOnly bool) bson.M {
+	filter := bson.M{"_id": oid}
+	if publishedOnly {
+		filter["state"] = publishedState
+	}
+	return filter
+}
+
+// create inserts a new blog at version 1 into mongodb and returns it
+func (s *Server) create(ctx context.Context, edit blogEdit) (*blogItem, error) {
+	now := storeNow()
+	data := &blogItem{
+		AuthorID:   edit.authorID,
+		Content:    edit.content,
+		Title:      edit.title,
+		State:      edit.state,
+		Tags:       edit.tags,
+		Version:    1,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if data.State == statePublished {
+		data.PublishTime = now
+	}
+	if edit.slug != nil {
+		data.Slug = *edit.slug
+	}
+
+	// insert one record in mongo collection and pass underlaying error as grpc error code & status
+	storeCtx, end := storeOp(ctx, s.collection, "insert")
+	res, err := s.collection.InsertOne(storeCtx, data)
+	end(err)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, slugTaken(data.Slug)
+	}
+	if err != nil {
+		return nil, storeError("Cannot insert blog into mongodb", err)
+	}
+
+	// if successfully inserted the get the objectID created and caste it to objectID
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, rpcerr.Internal(rpcerr.ReasonInternal, "cannot convert to objectID")
+	}
+	data.ID = oid
+
+	// new blog must show up in related-posts recommendations
+	s.related.invalidate()
+	return data, nil
+}
+
+// get fetches the blog for given blogID from mongodb and, with countView, counts a view if it is published
+// field is the request field of blogID, reported if it cannot be parsed
+// throws NOT_FOUND error if blog is not found in mongodb
+func (s *Server) get(ctx context.Context, field, blogID string, publishedOnly, countView bool) (*blogItem, error) {
+	// parse blogID as ObjectID
+	oid, err := primitive.ObjectIDFromHex(blogID)
+	if err != nil {
+		return nil, invalidBlogID(field, err)
+	}
+
+	// query mongodb with given blogID and parse the response into a struct
+	data := &blogItem{}
+	storeCtx, end := storeOp(ctx, s.collection, "findOne")
+	err = s.collection.FindOne(storeCtx, blogFilter(oid, publishedOnly)).Decode(data)
+	end(err)
+	if err != nil {
+		return nil, findError(blogID, err)
+	}
+
+	// count the view, it is written to mongodb with the next batch
+	if countView && data.published() {
+		s.views.record(oid)
+	}
+	return data, nil
+}
+
+// getBySlug fetches the blog with the given slug from mongodb in any state and, with countView,
+// counts a view if it is published
+// throws NOT_FOUND error if no blog has the slug
+func (s *Server) getBySlug(ctx context.Context, slug string, countView bool) (*blogItem, error) {
+	if slug == "" {
+		return nil, rpcerr.InvalidArgument(rpcerr.ReasonInvalidArgument, "slug must be set",
+			rpcerr.FieldViolation("slug", "must not be empty"))
+	}
+
+	data := &blogItem{}
+	storeCtx, end := storeOp(ctx, s.collection, "findOne")
+	err := s.collection.FindOne(storeCtx, bson.M{"slug": slug}).Decode(data)
+	end(err)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, slugNotFound(slug)
+	}
+	if err != nil {
+		return nil, storeError("Cannot read blog from mongodb", err)
+	}
+
+	// count the view, it is written to mongodb with the next batch
+	if countView && data.published() {
+		s.views.record(data.ID)
+	}
+	return data, nil
+}
+
+// update applies edit to the blog in mongodb, increments its version and returns the updated blog
+// only the edited fields are set so view counts flushed in the meantime are kept
+// throws NOT_FOUND error if blog is not found in mongodb and ABORTED if it is not at edit.version
+func (s *Server) update(ctx context.Context, blogID string, edit blogEdit, publishedOnly bool) (*blogItem, error) {
+	// parse blogID as ObjectID
+	oid, err := primitive.ObjectIDFromHex(blogID)
+	if err != nil {
+		return nil, invalidBlogID("blog.id", err)
+	}
+
+	now := storeNow()
+	set := bson.M{
+		"author_id":   edit.authorID,
+		"title":       edit.title,
+		"content":     edit.content,
+		"update_time": now,
+	}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": int64(1)}}
+	if edit.state != "" {
+		set["state"] = edit.state
+	}
+	if edit.tags != nil {
+		set["tags"] = edit.tags
+	}
+	if edit.slug != nil && *edit.slug != "" {
+		set["slug"] = *edit.slug
+	} else if edit.slug != nil {
+		// blogs without slug have no slug field, the unique index only covers the others
+		update["$unset"] = bson.M{"slug": ""}
+	}
+	if edit.state == statePublished {
+		// $min only sets a missing publish_time, it keeps the time of the first publication
+		update["$min"] = bson.M{"publish_time": now}
+	}
+	filter := blogFilter(oid, publishedOnly)
+	if edit.version != 0 {
+		filter["version"] = edit.version
+	}
+
+	// update blog document and read it back in one operation
+	data := &blogItem{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	storeCtx, end := storeOp(ctx, s.collection, "findOneAndUpdate")
+	err = s.collection.FindOneAndUpdate(storeCtx, filter, update, opts).Decode(data)
+	end(err)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, s.unmatched(ctx, oid, blogID, edit.version, publishedOnly)
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, slugTaken(*edit.slug)
+	}
+	if err != nil {
+		return nil, storeError("Cannot update object in mongodb", err)
+	}
+
+	// changed blog must be re-scored for related-posts recommendations
+	s.related.invalidate()
+	return data, nil
+}
+
+// delete takes a blogID and deletes the blog document from mongodb
+// throws NOT_FOUND error if blog is not found in mongodb and ABORTED if it is not at version, unless version is 0
+func (s *Server) delete(ctx context.Context, blogID string, version int64, publishedOnly bool) error {
+	// parse blogID as ObjectID
+	oid, err := primitive.ObjectIDFromHex(blogID)
+	if err != nil {
+		return invalidBlogID("blog_id", err)
+	}
+	filter := blogFilter(oid, publishedOnly)
+	if version != 0 {
+		filter["version"] = version
+	}
+
+	// delete documnet from mongodb
+	storeCtx, end := storeOp(ctx, s.collection, "deleteOne")
+	res, err := s.collection.DeleteOne(storeCtx, filter)
+	end(err)
+	if err != nil {
+		return storeError("Cannot delete object from mongodb", err)
+	}
+
+	// check if any item is deleted from mongodb or not
+	if res.DeletedCount == 0 {
+		return s.unmatched(ctx, oid, blogID, version, publishedOnly)
+	}
+
+	// deleted blog must not be recommended any more
+	s.related.invalidate()
+	return nil
+}
+
+// unmatched returns the error of a change of the blog that matched no document:
+// NOT_FOUND if the blog does not exist, ABORTED if it exists at another version than the change is based on
+func (s *Server) unmatched(ctx context.Context, oid primitive.ObjectID, blogID string, version int64, publishedOnly bool) error {
+	if version == 0 {
+		return blogNotFound(blogID)
+	}
+	data := &blogItem{}
+	storeCtx, end := storeOp(ctx, s.collection, "findOne")
+	err := s.collection.FindOne(storeCtx, blogFilter(oid, publishedOnly)).Decode(data)
+	end(err)
+	if err != nil {
+		return findError(blogID, err)
+	}
+	return versionMismatch(blogID, version, data.Version)
+}
+
+// list passes every blog of mongodb matching filter to send, as it is read
+// throws underlaying error in case of any error
+func (s *Server) list(ctx context.Context, filter bson.M, send func(*blogItem) error) error {
+	// the store span covers the query and the iteration of the cursor
+	storeCtx, end := storeOp(ctx, s.collection, "find")
+	var findErr error
+	defer func() { end(findErr) }()
+
+	// get the cursor for list of blogs in mongodb
+	cur, err := s.collection.Find(storeCtx, filter)
+	if err != nil {
+		findErr = err
+		return storeError("Unknow internal error from mongodb", err)
+	}
+
+	// when function exist then cursor will be closed
+	defer cur.Close(storeCtx)
+
+	// iterate over cursor and find all blog elements
+	// decode blog and stream the response
+	for cur.Next(storeCtx) {
+		data := &blogItem{}
+		err := cur.Decode(data)
+		if err != nil {
+			return rpcerr.Internal(rpcerr.ReasonStoreFailure, fmt.Sprintf("error while decoding data from mongodb: %v", err))
+		}
+
+		// stream the response, stop reading the store once the client is gone
+		if err := send(data); err != nil {
+			return rpcerr.Stream(err, "error while sending blog")
+		}
+	}
+
+	// check for any unknown error from cursor
+	if err := cur.Err(); err != nil {
+		findErr = err
+		return storeError("Unknow internal error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/rahulsingh/go-grpc-examples/tracing"
+)
+
+// storeOp starts the span of a mongodb operation on collection, a child of the span of the call in ctx
+// end must be called with the error of the operation; a missing document is not a store failure
+//
+// the returned context keeps the deadline and cancellation of ctx,
+// so store calls stop as soon as the client gives up
+func storeOp(ctx context.Context, collection *mongo.Collection, operation string) (context.Context, func(error)) {
+	ctx, end := tracing.StartStoreSpan(ctx, "mongodb", collection.Name(), operation)
+	return ctx, func(err error) {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = nil
+		}
+		end(err)
+	}
+}
@@ -0,0 +1,134 @@
+package blogservice
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// translation of the blog.v1 and blog.v2 messages from and to the data-model of the store
+// blog.v1 predates states, versions and times: its clients see the published blogs, the blogs they create
+// are published right away and their changes apply to any version without changing the state
+
+// blogToV1 converts the data-model object into the blog.v1 Blog returned to clients
+func blogToV1(data *blogItem) *blogv1.Blog {
+	return &blogv1.Blog{
+		Id:        data.ID.Hex(),
+		AuthorId:  data.AuthorID,
+		Title:     data.Title,
+		Content:   data.Content,
+		ViewCount: data.ViewCount,
+	}
+}
+
+// blogToV2 converts the data-model object into the blog.v2 Blog returned to clients
+func blogToV2(data *blogItem) *blogv2.Blog {
+	return &blogv2.Blog{
+		Id:          data.ID.Hex(),
+		AuthorId:    data.AuthorID,
+		Title:       data.Title,
+		Content:     data.Content,
+		ViewCount:   data.ViewCount,
+		State:       stateToV2(data.State),
+		Version:     data.Version,
+		CreateTime:  timestamp(data.CreateTime),
+		UpdateTime:  timestamp(data.UpdateTime),
+		PublishTime: timestamp(data.PublishTime),
+		Tags:        data.Tags,
+		Slug:        data.Slug,
+	}
+}
+
+// timestamp converts t into a Timestamp message, nil for the zero time of a field never set
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// editFromV1 returns the change of a blog requested with a blog.v1 Blog
+func editFromV1(blog *blogv1.Blog) blogEdit {
+	return blogEdit{
+		authorID: blog.GetAuthorId(),
+		title:    blog.GetTitle(),
+		content:  blog.GetContent(),
+	}
+}
+
+// editFromV2 returns the change of a blog requested with a blog.v2 Blog, state is its translated state
+// and tags its normalized tags, see normalizeTags
+func editFromV2(blog *blogv2.Blog, state string, tags []string) blogEdit {
+	slug := blog.GetSlug()
+	return blogEdit{
+		authorID: blog.GetAuthorId(),
+		title:    blog.GetTitle(),
+		content:  blog.GetContent(),
+		state:    state,
+		tags:     tags,
+		slug:     &slug,
+		version:  blog.GetVersion(),
+	}
+}
+
+// stateToV2 converts a stored state into its blog.v2 value
+func stateToV2(state string) blogv2.BlogState {
+	switch state {
+	case stateDraft:
+		return blogv2.BlogState_BLOG_STATE_DRAFT
+	case stateArchived:
+		return blogv2.BlogState_BLOG_STATE_ARCHIVED
+	default:
+		// blogs stored before migration 4 have no state, they were all public
+		return blogv2.BlogState_BLOG_STATE_PUBLISHED
+	}
+}
+
+// stateFromV2 converts a blog.v2 state of the request field into its stored value, "" if unspecified
+// a state unknown to the server is returned as a violation of field
+func stateFromV2(state blogv2.BlogState, field string) (string, []*errdetails.BadRequest_FieldViolation) {
+	switch state {
+	case blogv2.BlogState_BLOG_STATE_UNSPECIFIED:
+		return "", nil
+	case blogv2.BlogState_BLOG_STATE_DRAFT:
+		return stateDraft, nil
+	case blogv2.BlogState_BLOG_STATE_PUBLISHED:
+		return statePublished, nil
+	case blogv2.BlogState_BLOG_STATE_ARCHIVED:
+		return stateArchived, nil
+	default:
+		return "", []*errdetails.BadRequest_FieldViolation{
+			rpcerr.FieldViolation(field, fmt.Sprintf("unknown state %d", state)),
+		}
+	}
+}
+
+// windowFromV1 returns the duration of a blog.v1 popularity window, 0 for all time
+func windowFromV1(window blogv1.PopularityWindow) time.Duration {
+	switch window {
+	case blogv1.PopularityWindow_DAY:
+		return dayWindow
+	case blogv1.PopularityWindow_WEEK:
+		return weekWindow
+	default:
+		return 0
+	}
+}
+
+// windowFromV2 returns the duration of a blog.v2 popularity window, 0 for all time
+func windowFromV2(window blogv2.PopularityWindow) time.Duration {
+	switch window {
+	case blogv2.PopularityWindow_POPULARITY_WINDOW_DAY:
+		return dayWindow
+	case blogv2.PopularityWindow_POPULARITY_WINDOW_WEEK:
+		return weekWindow
+	default:
+		return 0
+	}
+}
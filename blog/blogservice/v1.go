@@ -0,0 +1,143 @@
+package blogservice
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+)
+
+// v1Server implements blogv1.BlogServiceServer on the store, see translate.go for how blog.v1 maps to it
+// methods added to the service answer UNIMPLEMENTED until v1Server implements them
+type v1Server struct {
+	blogv1.UnimplementedBlogServiceServer
+
+	store *Server
+}
+
+// CreateBlog is used to insert one record of blog into mongodb and return response and throws underlaying error
+// the blog is published right away
+func (s *v1Server) CreateBlog(ctx context.Context, req *blogv1.CreateBlogRequest) (*blogv1.CreateBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for creating a blog")
+
+	// get the blog instance from request and validate it
+	blog := req.GetBlog()
+	if err := validateBlog(blog, blog != nil); err != nil {
+		return nil, err
+	}
+
+	edit := editFromV1(blog)
+	edit.state = statePublished
+	data, err := s.store.create(ctx, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	// return blog instance with blogID
+	return &blogv1.CreateBlogResponse{
+		Blog: blogToV1(data),
+	}, nil
+}
+
+// ReadBlog fetch the Blog from mongodb for given blogID and return NOT_FOUND error if blog is not found in db
+func (s *v1Server) ReadBlog(ctx context.Context, req *blogv1.ReadBlogRequest) (*blogv1.ReadBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for ReadBlog", "blog_id", req.GetBlogId())
+
+	data, err := s.store.get(ctx, "blog_id", req.GetBlogId(), true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// return success response with Blog object
+	return &blogv1.ReadBlogResponse{
+		Blog: blogToV1(data),
+	}, nil
+}
+
+// UpdateBlog updated the given blog in mongodb and return updated blog instance
+// throws NOT_FOUND error if blog is not found in mongodb
+func (s *v1Server) UpdateBlog(ctx context.Context, req *blogv1.UpdateBlogRequest) (*blogv1.UpdateBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for UpdateBlog", "blog_id", req.GetBlog().GetId())
+
+	// read blog from request and validate it
+	blog := req.GetBlog()
+	if err := validateBlog(blog, blog != nil); err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.update(ctx, blog.GetId(), editFromV1(blog), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogv1.UpdateBlogResponse{
+		Blog: blogToV1(data),
+	}, nil
+}
+
+// DeleteBlog takes a blogID and delete blog document from mongodb and return successfully deleted blogID
+// Throws underlaying error and NOT_FOUND if blog does not found in mongodb for gievn blogID
+func (s *v1Server) DeleteBlog(ctx context.Context, req *blogv1.DeleteBlogRequest) (*blogv1.DeleteBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for DeleteBlog", "blog_id", req.GetBlogId())
+
+	if err := s.store.delete(ctx, req.GetBlogId(), 0, true); err != nil {
+		return nil, err
+	}
+
+	// successfully deleted blog
+	return &blogv1.DeleteBlogResponse{
+		BlogId: req.GetBlogId(),
+	}, nil
+}
+
+// ListBlog used to stream list of all published blogs from mongodb
+// throws underlaying error in case of any error
+func (s *v1Server) ListBlog(req *blogv1.ListBlogRequest, stream blogv1.BlogService_ListBlogServer) error {
+	logging.FromContext(stream.Context()).Debug("Request received for ListBlog Streaming")
+
+	return s.store.list(stream.Context(), bson.M{"state": publishedState}, func(data *blogItem) error {
+		return stream.Send(&blogv1.ListBlogResponse{Blog: blogToV1(data)})
+	})
+}
+
+// ListPopularBlogs returns the most viewed blogs over the requested window
+func (s *v1Server) ListPopularBlogs(ctx context.Context, req *blogv1.ListPopularBlogsRequest) (*blogv1.ListPopularBlogsResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for ListPopularBlogs", "window", req.GetWindow().String())
+
+	ranking, err := s.store.popular(ctx, windowFromV1(req.GetWindow()), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &blogv1.ListPopularBlogsResponse{}
+	for _, ranked := range ranking {
+		res.Blogs = append(res.Blogs, &blogv1.PopularBlog{
+			Blog:        blogToV1(ranked.item),
+			WindowViews: ranked.views,
+		})
+	}
+	return res, nil
+}
+
+// GetRelatedBlogs returns the K blogs most similar to the given blog based on shared tags, same author
+// and TF-IDF cosine similarity of title and content
+// throws NOT_FOUND error if blog is not found in mongodb
+func (s *v1Server) GetRelatedBlogs(ctx context.Context, req *blogv1.GetRelatedBlogsRequest) (*blogv1.GetRelatedBlogsResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for GetRelatedBlogs", "blog_id", req.GetBlogId())
+
+	scores, err := s.store.relatedBlogs(ctx, req.GetBlogId(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &blogv1.GetRelatedBlogsResponse{}
+	for _, scored := range scores {
+		res.Blogs = append(res.Blogs, &blogv1.RelatedBlog{
+			Blog:  blogToV1(scored.doc.item),
+			Score: scored.score,
+		})
+	}
+	return res, nil
+}
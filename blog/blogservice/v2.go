@@ -0,0 +1,159 @@
+package blogservice
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// v2Server implements blogv2.BlogServiceServer on the store
+// methods added to the service answer UNIMPLEMENTED until v2Server implements them
+type v2Server struct {
+	blogv2.UnimplementedBlogServiceServer
+
+	store *Server
+}
+
+// CreateBlog inserts the blog into mongodb at version 1, as a draft unless another state is requested
+func (s *v2Server) CreateBlog(ctx context.Context, req *blogv2.CreateBlogRequest) (*blogv2.CreateBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for creating a blog", "state", req.GetBlog().GetState().String())
+
+	// get the blog instance from request and validate it
+	blog := req.GetBlog()
+	state, violations := stateFromV2(blog.GetState(), "blog.state")
+	tags := normalizeTags(blog.GetTags())
+	violations = append(violations, validateTags(tags, "blog.tags")...)
+	if err := validateBlog(blog, blog != nil, violations...); err != nil {
+		return nil, err
+	}
+	if state == "" {
+		state = stateDraft
+	}
+
+	data, err := s.store.create(ctx, editFromV2(blog, state, tags))
+	if err != nil {
+		return nil, err
+	}
+	return &blogv2.CreateBlogResponse{Blog: blogToV2(data)}, nil
+}
+
+// GetBlog fetches the blog from mongodb in any state, only views of published blogs are counted, none with skip_view
+// throws NOT_FOUND error if blog is not found in mongodb
+func (s *v2Server) GetBlog(ctx context.Context, req *blogv2.GetBlogRequest) (*blogv2.GetBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for GetBlog", "blog_id", req.GetBlogId(), "skip_view", req.GetSkipView())
+
+	data, err := s.store.get(ctx, "blog_id", req.GetBlogId(), false, !req.GetSkipView())
+	if err != nil {
+		return nil, err
+	}
+	return &blogv2.GetBlogResponse{Blog: blogToV2(data)}, nil
+}
+
+// GetBlogBySlug fetches the blog with the given slug like GetBlog
+// throws NOT_FOUND error if no blog has the slug
+func (s *v2Server) GetBlogBySlug(ctx context.Context, req *blogv2.GetBlogBySlugRequest) (*blogv2.GetBlogBySlugResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for GetBlogBySlug", "slug", req.GetSlug(), "skip_view", req.GetSkipView())
+
+	data, err := s.store.getBySlug(ctx, req.GetSlug(), !req.GetSkipView())
+	if err != nil {
+		return nil, err
+	}
+	return &blogv2.GetBlogBySlugResponse{Blog: blogToV2(data)}, nil
+}
+
+// UpdateBlog replaces the editable fields and the state, if specified, of the blog in mongodb
+// throws NOT_FOUND error if blog is not found in mongodb and ABORTED if it is not at the version of the request
+func (s *v2Server) UpdateBlog(ctx context.Context, req *blogv2.UpdateBlogRequest) (*blogv2.UpdateBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for UpdateBlog", "blog_id", req.GetBlog().GetId(), "version", req.GetBlog().GetVersion())
+
+	// read blog from request and validate it
+	blog := req.GetBlog()
+	state, violations := stateFromV2(blog.GetState(), "blog.state")
+	tags := normalizeTags(blog.GetTags())
+	violations = append(violations, validateTags(tags, "blog.tags")...)
+	if err := validateBlog(blog, blog != nil, violations...); err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.update(ctx, blog.GetId(), editFromV2(blog, state, tags), false)
+	if err != nil {
+		return nil, err
+	}
+	return &blogv2.UpdateBlogResponse{Blog: blogToV2(data)}, nil
+}
+
+// DeleteBlog deletes the blog document from mongodb
+// throws NOT_FOUND error if blog is not found in mongodb and ABORTED if it is not at the version of the request
+func (s *v2Server) DeleteBlog(ctx context.Context, req *blogv2.DeleteBlogRequest) (*blogv2.DeleteBlogResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for DeleteBlog", "blog_id", req.GetBlogId(), "version", req.GetVersion())
+
+	if err := s.store.delete(ctx, req.GetBlogId(), req.GetVersion(), false); err != nil {
+		return nil, err
+	}
+	return &blogv2.DeleteBlogResponse{BlogId: req.GetBlogId()}, nil
+}
+
+// ListBlogs streams the blogs of mongodb in the requested state, all blogs if unspecified
+func (s *v2Server) ListBlogs(req *blogv2.ListBlogsRequest, stream blogv2.BlogService_ListBlogsServer) error {
+	logging.FromContext(stream.Context()).Debug("Request received for ListBlogs Streaming", "state", req.GetState().String())
+
+	state, violations := stateFromV2(req.GetState(), "state")
+	if len(violations) > 0 {
+		return rpcerr.InvalidArgument(rpcerr.ReasonInvalidArgument, "invalid state", violations...)
+	}
+	filter := bson.M{}
+	switch state {
+	case "":
+	case statePublished:
+		filter["state"] = publishedState
+	default:
+		filter["state"] = state
+	}
+
+	return s.store.list(stream.Context(), filter, func(data *blogItem) error {
+		return stream.Send(&blogv2.ListBlogsResponse{Blog: blogToV2(data)})
+	})
+}
+
+// ListPopularBlogs returns the most viewed published blogs over the requested window
+func (s *v2Server) ListPopularBlogs(ctx context.Context, req *blogv2.ListPopularBlogsRequest) (*blogv2.ListPopularBlogsResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for ListPopularBlogs", "window", req.GetWindow().String())
+
+	ranking, err := s.store.popular(ctx, windowFromV2(req.GetWindow()), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &blogv2.ListPopularBlogsResponse{}
+	for _, ranked := range ranking {
+		res.Blogs = append(res.Blogs, &blogv2.PopularBlog{
+			Blog:        blogToV2(ranked.item),
+			WindowViews: ranked.views,
+		})
+	}
+	return res, nil
+}
+
+// GetRelatedBlogs returns the K published blogs most similar to the given blog
+// throws NOT_FOUND error if blog is not found in mongodb or not published
+func (s *v2Server) GetRelatedBlogs(ctx context.Context, req *blogv2.GetRelatedBlogsRequest) (*blogv2.GetRelatedBlogsResponse, error) {
+	logging.FromContext(ctx).Debug("Request received for GetRelatedBlogs", "blog_id", req.GetBlogId())
+
+	scores, err := s.store.relatedBlogs(ctx, req.GetBlogId(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &blogv2.GetRelatedBlogsResponse{}
+	for _, scored := range scores {
+		res.Blogs = append(res.Blogs, &blogv2.RelatedBlog{
+			Blog:  blogToV2(scored.doc.item),
+			Score: scored.score,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package blogservice
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// limits applied to the fields of a blog
+const (
+	maxAuthorIDLength = 64
+	maxTitleLength    = 200
+	maxContentBytes   = 64 * 1024
+	maxTags           = 10
+	maxTagLength      = 32
+	maxSlugLength     = 100
+)
+
+// tagPattern are the characters allowed in a tag after normalizeTags
+var tagPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// slugPattern matches slugs: words of lower-case letters and digits joined by single '-'
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// blogFields are the editable fields of the Blog messages of all API versions
+type blogFields interface {
+	GetAuthorId() string
+	GetTitle() string
+	GetContent() string
+}
+
+// fieldRule declares the constraints for a single field of the blog
+// every non-zero constraint is checked and each failure is reported as one field violation
+type fieldRule struct {
+	field    string                  // field path reported to the client, e.g. "blog.title"
+	value    func(blogFields) string // getter for the field value
+	required bool                    // value must not be empty
+	maxLen   int                     // maximum number of characters
+	maxBytes int                     // maximum size in bytes
+	pattern  *regexp.Regexp          // allowed characters
+	allowed  string                  // human readable description of pattern
+}
+
+// blogRules are the validation rules enforced on create and update of a blog
+var blogRules = []fieldRule{
+	{
+		field:    "blog.author_id",
+		value:    blogFields.GetAuthorId,
+		required: true,
+		maxLen:   maxAuthorIDLength,
+		pattern:  regexp.MustCompile(`^[A-Za-z0-9 ._-]*$`),
+		allowed:  "letters, digits, spaces, '.', '_' and '-'",
+	},
+	{
+		field:    "blog.title",
+		value:    blogFields.GetTitle,
+		required: true,
+		maxLen:   maxTitleLength,
+	},
+	{
+		field:    "blog.content",
+		value:    blogFields.GetContent,
+		maxBytes: maxContentBytes,
+	},
+	{
+		field:   "blog.slug",
+		value:   slugOf,
+		maxLen:  maxSlugLength,
+		pattern: slugPattern,
+		allowed: "lower-case letters and digits, in words joined by single '-'",
+	},
+}
+
+// slugOf returns the slug of a blog.v2 blog, blog.v1 blogs have none
+func slugOf(blog blogFields) string {
+	if b, ok := blog.(interface{ GetSlug() string }); ok {
+		return b.GetSlug()
+	}
+	return ""
+}
+
+// check validates the field value against the rule and returns the violations found
+func (r fieldRule) check(blog blogFields) []*errdetails.BadRequest_FieldViolation {
+	value := r.value(blog)
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(format string, args ...interface{}) {
+		violations = append(violations, rpcerr.FieldViolation(r.field, fmt.Sprintf(format, args...)))
+	}
+
+	if value == "" {
+		if r.required {
+			violate("must not be empty")
+		}
+		return violations
+	}
+	if r.maxLen > 0 && utf8.RuneCountInString(value) > r.maxLen {
+		violate("must be at most %d characters long", r.maxLen)
+	}
+	if r.maxBytes > 0 && len(value) > r.maxBytes {
+		violate("must be at most %d bytes long", r.maxBytes)
+	}
+	if r.pattern != nil && !r.pattern.MatchString(value) {
+		violate("may only contain %s", r.allowed)
+	}
+	return violations
+}
+
+// validateBlog checks the blog against blogRules and returns an INVALID_ARGUMENT error
+// carrying google.rpc.BadRequest details with one field violation per failed rule
+// it returns nil if the blog is valid, set is false if the request has no blog
+// extra are the violations of fields of the request checked by the caller, reported with the others
+func validateBlog(blog blogFields, set bool, extra ...*errdetails.BadRequest_FieldViolation) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if !set {
+		violations = append(violations, rpcerr.FieldViolation("blog", "must be set"))
+	} else {
+		for _, rule := range blogRules {
+			violations = append(violations, rule.check(blog)...)
+		}
+	}
+	violations = append(violations, extra...)
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return rpcerr.InvalidArgument(rpcerr.ReasonInvalidArgument, "invalid blog", violations...)
+}
+
+// normalizeTags lower-cases and trims tags and drops empty and repeated ones,
+// the result is never nil so that an update without tags clears them
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// validateTags returns the violations of tags normalized by normalizeTags, reported under field
+func validateTags(tags []string, field string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if len(tags) > maxTags {
+		violations = append(violations, rpcerr.FieldViolation(field, fmt.Sprintf("must have at most %d tags", maxTags)))
+	}
+	for i, tag := range tags {
+		if utf8.RuneCountInString(tag) > maxTagLength || !tagPattern.MatchString(tag) {
+			violations = append(violations, rpcerr.FieldViolation(fmt.Sprintf("%v[%d]", field, i),
+				fmt.Sprintf("must be at most %d letters, digits and '-'", maxTagLength)))
+		}
+	}
+	return violations
+}
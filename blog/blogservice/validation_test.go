@@ -0,0 +1,194 @@
+package blogservice
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// violatedFields returns the fields of the violations, in order
+func violatedFields(violations []*errdetails.BadRequest_FieldViolation) []string {
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.GetField())
+	}
+	return fields
+}
+
+// badRequestFields returns the fields of the BadRequest details of err, which must be INVALID_ARGUMENT
+func badRequestFields(t *testing.T, err error) []string {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("error %v, want INVALID_ARGUMENT", err)
+	}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			return violatedFields(badRequest.GetFieldViolations())
+		}
+	}
+	t.Fatalf("error %v without BadRequest details", err)
+	return nil
+}
+
+func TestFieldRuleCheck(t *testing.T) {
+	rule := fieldRule{
+		field:    "blog.author_id",
+		value:    blogFields.GetAuthorId,
+		required: true,
+		maxLen:   5,
+		maxBytes: 8,
+		pattern:  regexp.MustCompile(`^[^_]*$`),
+		allowed:  "anything but '_'",
+	}
+	tests := []struct {
+		name  string
+		value string
+		want  []string // descriptions of the violations
+	}{
+		{"valid", "ab-c", nil},
+		{"empty", "", []string{"must not be empty"}},
+		{"too long", "abcdef", []string{"must be at most 5 characters long"}},
+		{"characters not bytes", "éééé", nil},
+		{"too many bytes", "ééééé", []string{"must be at most 8 bytes long"}},
+		{"pattern", "a_b", []string{"may only contain anything but '_'"}},
+		{"every failure", "A_BCDEFGHI", []string{
+			"must be at most 5 characters long", "must be at most 8 bytes long", "may only contain anything but '_'",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := rule.check(&blogv2.Blog{AuthorId: tt.value})
+			var got []string
+			for _, violation := range violations {
+				if violation.GetField() != "blog.author_id" {
+					t.Errorf("violation of %q, want blog.author_id", violation.GetField())
+				}
+				got = append(got, violation.GetDescription())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("check(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	optional := fieldRule{field: "blog.content", value: blogFields.GetContent, maxBytes: 1}
+	if violations := optional.check(&blogv2.Blog{}); len(violations) > 0 {
+		t.Errorf("optional empty field: %v", violations)
+	}
+}
+
+func TestValidateBlog(t *testing.T) {
+	valid := func() *blogv2.Blog {
+		return &blogv2.Blog{AuthorId: "rahul", Title: "Streaming with gRPC", Content: "...", Slug: "streaming-with-grpc"}
+	}
+	tests := []struct {
+		name  string
+		blog  blogFields
+		set   bool
+		extra []*errdetails.BadRequest_FieldViolation
+		want  []string // fields of the BadRequest violations, none if valid
+	}{
+		{"valid v2", valid(), true, nil, nil},
+		{"valid v1", &blogv1.Blog{AuthorId: "rahul", Title: "Streaming with gRPC"}, true, nil, nil},
+		{"no blog", (*blogv2.Blog)(nil), false, nil, []string{"blog"}},
+		{"empty", &blogv2.Blog{}, true, nil, []string{"blog.author_id", "blog.title"}},
+		{"author characters", func() blogFields { b := valid(); b.AuthorId = "rahul@example.com"; return b }(), true, nil,
+			[]string{"blog.author_id"}},
+		{"long title", func() blogFields { b := valid(); b.Title = strings.Repeat("t", maxTitleLength+1); return b }(), true, nil,
+			[]string{"blog.title"}},
+		{"large content", func() blogFields { b := valid(); b.Content = strings.Repeat("c", maxContentBytes+1); return b }(), true, nil,
+			[]string{"blog.content"}},
+		{"slug", func() blogFields { b := valid(); b.Slug = "Streaming--gRPC"; return b }(), true, nil,
+			[]string{"blog.slug"}},
+		{"extra violations last", &blogv2.Blog{Title: "t"}, true,
+			[]*errdetails.BadRequest_FieldViolation{rpcerr.FieldViolation("blog.tags[0]", "bad tag")},
+			[]string{"blog.author_id", "blog.tags[0]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlog(tt.blog, tt.set, tt.extra...)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("validateBlog: %v", err)
+				}
+				return
+			}
+			if got := badRequestFields(t, err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violated fields %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugPattern(t *testing.T) {
+	for slug, valid := range map[string]bool{
+		"streaming-with-grpc": true,
+		"grpc2":               true,
+		"a":                   true,
+		"Streaming":           false,
+		"-grpc":               false,
+		"grpc-":               false,
+		"grpc--web":           false,
+		"grpc_web":            false,
+		"grpc web":            false,
+	} {
+		if got := slugPattern.MatchString(slug); got != valid {
+			t.Errorf("slug %q valid = %v, want %v", slug, got, valid)
+		}
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"none", nil, []string{}},
+		{"lower-cased and trimmed", []string{" Go ", "gRPC"}, []string{"go", "grpc"}},
+		{"repeated and empty dropped", []string{"go", "", "GO", "  ", "grpc", "go"}, []string{"go", "grpc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeTags(tt.tags)
+			if got == nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTags(%q) = %#v, want %#v", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	tooMany := make([]string, maxTags+1)
+	for i := range tooMany {
+		tooMany[i] = "tag"
+	}
+	tests := []struct {
+		name string
+		tags []string
+		want []string // fields of the violations
+	}{
+		{"valid", []string{"go", "grpc-web", "http2"}, nil},
+		{"none", []string{}, nil},
+		{"characters", []string{"go", "c++", "grpc web"}, []string{"blog.tags[1]", "blog.tags[2]"}},
+		{"length", []string{strings.Repeat("t", maxTagLength), strings.Repeat("t", maxTagLength+1)}, []string{"blog.tags[1]"}},
+		{"too many", tooMany, []string{"blog.tags"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violatedFields(validateTags(tt.tags, "blog.tags")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violated fields %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
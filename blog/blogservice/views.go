@@ -0,0 +1,276 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+	"github.com/rahulsingh/go-grpc-examples/tracing"
+)
+
+// settings of view counting and popularity ranking
+const (
+	viewFlushInterval   = 10 * time.Second // how often buffered views are written to mongodb
+	defaultPopularLimit = 10
+	maxPopularLimit     = 100
+	dayWindow           = 24 * time.Hour // popularity over the last day
+	weekWindow          = 7 * dayWindow  // popularity over the last week
+)
+
+// data-model object for an hourly bucket of views of one blog
+type viewBucket struct {
+	BlogID primitive.ObjectID `bson:"blog_id"`
+	Hour   time.Time          `bson:"hour"`
+	Count  int64              `bson:"count"`
+}
+
+// viewCounter counts blog views in memory so that reads don't each cause a write
+// counts are written in one batch per flush: the blog view_count and the hourly bucket are incremented
+type viewCounter struct {
+	blogs   *mongo.Collection // blog documents holding the total view_count
+	buckets *mongo.Collection // hourly view buckets
+
+	mu      sync.Mutex
+	pending map[primitive.ObjectID]int64
+}
+
+func newViewCounter(blogs, buckets *mongo.Collection) *viewCounter {
+	return &viewCounter{
+		blogs:   blogs,
+		buckets: buckets,
+		pending: map[primitive.ObjectID]int64{},
+	}
+}
+
+// record counts one view of the blog
+func (v *viewCounter) record(oid primitive.ObjectID) {
+	v.mu.Lock()
+	v.pending[oid]++
+	v.mu.Unlock()
+}
+
+// take returns the buffered views and resets the buffer
+func (v *viewCounter) take() map[primitive.ObjectID]int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	pending := v.pending
+	v.pending = map[primitive.ObjectID]int64{}
+	return pending
+}
+
+// putBack returns views that could not be written to the buffer so the next flush retries them
+func (v *viewCounter) putBack(pending map[primitive.ObjectID]int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for oid, count := range pending {
+		v.pending[oid] += count
+	}
+}
+
+// flush writes the buffered views to mongodb with one bulk write per collection
+func (v *viewCounter) flush(ctx context.Context) error {
+	pending := v.take()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	hour := time.Now().UTC().Truncate(time.Hour)
+	oids := make([]primitive.ObjectID, 0, len(pending))
+	blogWrites := make([]mongo.WriteModel, 0, len(pending))
+	for oid, count := range pending {
+		oids = append(oids, oid)
+		blogWrites = append(blogWrites, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": oid}).
+			SetUpdate(bson.M{"$inc": bson.M{"view_count": count}}))
+	}
+
+	// the flush is background work, its spans start a trace of their own
+	ctx, span := tracing.StartSpan(ctx, "blog.views.flush", attribute.Int("blog.views.blogs", len(pending)))
+	defer span.End()
+
+	unordered := options.BulkWrite().SetOrdered(false)
+	storeCtx, end := storeOp(ctx, v.blogs, "bulkWrite")
+	_, err := v.blogs.BulkWrite(storeCtx, blogWrites, unordered)
+	end(err)
+	failed, known := failedWrites(err, oids)
+	if !known {
+		// the writes may have been applied, retrying them could count the views twice
+		return fmt.Errorf("cannot update view counts, dropped %d views: %v", viewCount(pending), err)
+	}
+	// the other writes of the unordered bulk write were applied, only the failed ones are retried
+	retry := make(map[primitive.ObjectID]int64, len(failed))
+	for _, oid := range failed {
+		retry[oid] = pending[oid]
+		delete(pending, oid)
+	}
+	v.putBack(retry)
+
+	if len(pending) > 0 {
+		bucketWrites := make([]mongo.WriteModel, 0, len(pending))
+		for oid, count := range pending {
+			bucketWrites = append(bucketWrites, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"blog_id": oid, "hour": hour}).
+				SetUpdate(bson.M{"$inc": bson.M{"count": count}}).
+				SetUpsert(true))
+		}
+		// buckets only feed the day and week rankings, a failure here is not retried
+		// to avoid counting the blog view_count twice
+		storeCtx, end = storeOp(ctx, v.buckets, "bulkWrite")
+		_, bucketErr := v.buckets.BulkWrite(storeCtx, bucketWrites, unordered)
+		end(bucketErr)
+		if bucketErr != nil {
+			return fmt.Errorf("cannot update view buckets: %v", bucketErr)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("cannot update %d of %d view counts: %v", len(failed), len(oids), err)
+	}
+	return nil
+}
+
+// failedWrites returns the blogs of oids, in the order of the writes of a bulk write, whose write failed with err
+// known is false if err does not tell which writes were applied, e.g. a network error or a timeout;
+// a write concern error leaves the writes without a write error applied
+func failedWrites(err error, oids []primitive.ObjectID) (failed []primitive.ObjectID, known bool) {
+	if err == nil {
+		return nil, true
+	}
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) {
+		return nil, false
+	}
+	failed = make([]primitive.ObjectID, 0, len(bulkErr.WriteErrors))
+	for _, writeErr := range bulkErr.WriteErrors {
+		if writeErr.Index >= 0 && writeErr.Index < len(oids) {
+			failed = append(failed, oids[writeErr.Index])
+		}
+	}
+	return failed, true
+}
+
+// viewCount returns the number of views of pending
+func viewCount(pending map[primitive.ObjectID]int64) int64 {
+	var n int64
+	for _, count := range pending {
+		n += count
+	}
+	return n
+}
+
+// run flushes the buffered views every interval until ctx is done, the last flush is left to Server.Close
+// each flush must finish within the interval so that a hung store does not hold up the next ones
+func (v *viewCounter) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			flushCtx, cancel := context.WithTimeout(context.Background(), interval)
+			if err := v.flush(flushCtx); err != nil {
+				slog.Error("error while flushing blog views", "error", err)
+			}
+			cancel()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// popularBlog is a blog of the popularity ranking with its number of views within the window
+type popularBlog struct {
+	item  *blogItem
+	views int64
+}
+
+// popular returns the most viewed published blogs over the window, all time if 0
+// views still buffered in memory are not counted until the next flush
+func (s *Server) popular(ctx context.Context, window time.Duration, limit int32) ([]popularBlog, error) {
+	// validate the limit and apply the server default
+	if limit < 0 || limit > maxPopularLimit {
+		return nil, rpcerr.InvalidArgument(
+			rpcerr.ReasonInvalidArgument,
+			fmt.Sprintf("limit must be between 0 and %d", maxPopularLimit),
+			rpcerr.FieldViolation("limit", fmt.Sprintf("must be between 0 and %d", maxPopularLimit)),
+		)
+	}
+	if limit == 0 {
+		limit = defaultPopularLimit
+	}
+
+	if window == 0 {
+		return s.popularAllTime(ctx, int64(limit))
+	}
+	return s.popularSince(ctx, time.Now().UTC().Add(-window), int64(limit))
+}
+
+// popularAllTime ranks published blogs by their total view_count
+func (s *Server) popularAllTime(ctx context.Context, limit int64) ([]popularBlog, error) {
+	storeCtx, end := storeOp(ctx, s.collection, "find")
+	var findErr error
+	defer func() { end(findErr) }()
+
+	opts := options.Find().SetSort(bson.D{{Key: "view_count", Value: -1}}).SetLimit(limit)
+	cur, err := s.collection.Find(storeCtx, bson.M{"view_count": bson.M{"$gt": 0}, "state": publishedState}, opts)
+	if err != nil {
+		findErr = err
+		return nil, storeError("Cannot query popular blogs", err)
+	}
+	defer cur.Close(storeCtx)
+
+	var ranking []popularBlog
+	for cur.Next(storeCtx) {
+		data := &blogItem{}
+		if err := cur.Decode(data); err != nil {
+			return nil, rpcerr.Internal(rpcerr.ReasonStoreFailure, fmt.Sprintf("error while decoding data from mongodb: %v", err))
+		}
+		ranking = append(ranking, popularBlog{item: data, views: data.ViewCount})
+	}
+	if err := cur.Err(); err != nil {
+		findErr = err
+		return nil, storeError("Unknow internal error", err)
+	}
+	return ranking, nil
+}
+
+// popularSince ranks published blogs by the sum of their hourly view buckets since the given time
+func (s *Server) popularSince(ctx context.Context, since time.Time, limit int64) ([]popularBlog, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"hour": bson.M{"$gte": since.Truncate(time.Hour)}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$blog_id", "count": bson.M{"$sum": "$count"}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		// join the ranked blogs, skipping blogs deleted or no longer published since they were viewed
+		{{Key: "$lookup", Value: bson.M{"from": s.collection.Name(), "localField": "_id", "foreignField": "_id", "as": "blog"}}},
+		{{Key: "$unwind", Value: "$blog"}},
+		{{Key: "$match", Value: bson.M{"blog.state": publishedState}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	var ranked []struct {
+		Blog  *blogItem `bson:"blog"`
+		Count int64     `bson:"count"`
+	}
+	storeCtx, end := storeOp(ctx, s.viewCollection, "aggregate")
+	cur, err := s.viewCollection.Aggregate(storeCtx, pipeline)
+	if err == nil {
+		err = cur.All(storeCtx, &ranked)
+	}
+	end(err)
+	if err != nil {
+		return nil, storeError("Cannot query popular blogs", err)
+	}
+
+	ranking := make([]popularBlog, 0, len(ranked))
+	for _, r := range ranked {
+		ranking = append(ranking, popularBlog{item: r.Blog, views: r.Count})
+	}
+	return ranking, nil
+}
@@ -0,0 +1,64 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestFailedWrites(t *testing.T) {
+	oids := []primitive.ObjectID{{11: 1}, {11: 2}, {11: 3}}
+	writeErrors := func(indexes ...int) []mongo.BulkWriteError {
+		var errs []mongo.BulkWriteError
+		for _, index := range indexes {
+			errs = append(errs, mongo.BulkWriteError{WriteError: mongo.WriteError{Index: index, Code: 2, Message: "bad value"}})
+		}
+		return errs
+	}
+	tests := []struct {
+		name       string
+		err        error
+		wantFailed []primitive.ObjectID
+		wantKnown  bool
+	}{
+		{"no error", nil, nil, true},
+		{"write errors", mongo.BulkWriteException{WriteErrors: writeErrors(0, 2)},
+			[]primitive.ObjectID{oids[0], oids[2]}, true},
+		{"wrapped write errors", fmt.Errorf("flush: %w", mongo.BulkWriteException{WriteErrors: writeErrors(1)}),
+			[]primitive.ObjectID{oids[1]}, true},
+		{"index out of range", mongo.BulkWriteException{WriteErrors: writeErrors(-1, 3)},
+			[]primitive.ObjectID{}, true},
+		{"write concern error applies the other writes", mongo.BulkWriteException{
+			WriteConcernError: &mongo.WriteConcernError{Code: 64, Message: "waiting for replication timed out"},
+			WriteErrors:       writeErrors(1),
+		}, []primitive.ObjectID{oids[1]}, true},
+		{"write concern error only", mongo.BulkWriteException{
+			WriteConcernError: &mongo.WriteConcernError{Code: 64, Message: "waiting for replication timed out"},
+		}, []primitive.ObjectID{}, true},
+		{"network error", errors.New("connection reset by peer"), nil, false},
+		{"timeout", context.DeadlineExceeded, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failed, known := failedWrites(tt.err, oids)
+			if known != tt.wantKnown {
+				t.Errorf("failedWrites known = %v, want %v", known, tt.wantKnown)
+			}
+			if !reflect.DeepEqual(failed, tt.wantFailed) {
+				t.Errorf("failedWrites = %v, want %v", failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestViewCount(t *testing.T) {
+	pending := map[primitive.ObjectID]int64{{11: 1}: 3, {11: 2}: 4}
+	if got := viewCount(pending); got != 7 {
+		t.Errorf("viewCount = %d, want 7", got)
+	}
+}
@@ -0,0 +1,165 @@
+// Package calculatorservice implements the CalculatorService gRPC API
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// checkEvery is how many divisors PrimeNumberDecomposition tries between checks of the call context
+const checkEvery = 1 << 16
+
+// Server implements calculatorv1.CalculatorServiceServer
+// methods added to the service answer UNIMPLEMENTED until Server implements them
+type Server struct {
+	calculatorv1.UnimplementedCalculatorServiceServer
+}
+
+// NewServer returns the CalculatorService implementation to register with a grpc.Server
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Implement all functions of generated interface CalculatorServiceServer i.e. Sum() in grcp server on server struct
+func (s *Server) Sum(ctx context.Context, req *calculatorv1.SumRequest) (*calculatorv1.SumResponse, error) {
+	logging.FromContext(ctx).Debug("Sum fun was invoked")
+	// Get the request data (first_number, second_number)
+	firstNumber := req.GetFirstNumber()
+	secondNumber := req.GetSecondNumber()
+
+	sum := (firstNumber + secondNumber)
+
+	res := &calculatorv1.SumResponse{
+		SumResult: sum,
+	}
+
+	return res, nil
+}
+
+func (s *Server) PrimeNumberDecomposition(req *calculatorv1.PrimeNumberDecompositionRequest, stream calculatorv1.CalculatorService_PrimeNumberDecompositionServer) error {
+	ctx := stream.Context()
+	logger := logging.FromContext(ctx)
+	logger.Debug("PrimeNumberDecomposition fun was invoked")
+	// Get the request data (input_number)
+	inputNumber := req.GetInputNumber()
+
+	// Get the prime number decomposition of given number and steam the response
+	divisor := int64(2)
+
+	for inputNumber > 1 {
+		if inputNumber%divisor == 0 {
+			err := stream.Send(&calculatorv1.PrimeNumberDecompositionResponse{
+				PrimeNumber: divisor,
+			})
+			if err != nil {
+				return rpcerr.Stream(err, "error while sending stream response")
+			}
+			inputNumber = inputNumber / divisor
+		} else {
+			divisor++
+			logger.Debug("Divisor has increased", "divisor", divisor)
+			// large primes take long, stop once the client is gone or out of time
+			if divisor%checkEvery == 0 {
+				if err := rpcerr.Context(ctx, "PrimeNumberDecomposition was stopped"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) ComputeAverage(stream calculatorv1.CalculatorService_ComputeAverageServer) error {
+	logging.FromContext(stream.Context()).Debug("ComputeAverage fun was invoked with stream request")
+	sum := int32(0)
+	count := 0
+	for {
+		// Keep of fetching client stream request till it reaches end-of-file
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// we have finished reading the client request stream
+			// the average of no numbers is undefined
+			if count == 0 {
+				return rpcerr.InvalidArgument(rpcerr.ReasonInvalidArgument, "at least one number is required",
+					rpcerr.FieldViolation("number", "send at least one number"))
+			}
+			// return the response on the same stream and close the stream
+			return stream.SendAndClose(&calculatorv1.ComputeAverageResponse{
+				Average: float32(sum) / float32(count),
+			})
+		}
+
+		if err != nil {
+			// the client canceled or broke the stream, only this call fails
+			return rpcerr.Stream(err, "error while reading client stream")
+		}
+
+		// Get the request numbers from steam and calculate their average
+		sum += req.GetNumber()
+		count++
+
+	}
+
+}
+
+func (s *Server) FindMaximum(stream calculatorv1.CalculatorService_FindMaximumServer) error {
+	logging.FromContext(stream.Context()).Debug("FindMaximum fun was invoked with stream request")
+	maximum := int32(0)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return rpcerr.Stream(err, "error while reading client stream")
+		}
+
+		number := req.GetNumber()
+		if number > maximum {
+			maximum = number
+			sendErr := stream.Send(&calculatorv1.FindMaximumResponse{
+				Maximum: maximum,
+			})
+			if sendErr != nil {
+				return rpcerr.Stream(sendErr, "error while sending stream response")
+			}
+		}
+
+	}
+}
+
+// SquareRoot is used to calculare squareroot of positive number
+// Error handling
+// This RPC will throw an execpetion if the sent number is negative
+// The error being sent if of type INVALID_ARGUMENT
+func (s *Server) SquareRoot(ctx context.Context, req *calculatorv1.SquareRootRequest) (*calculatorv1.SquareRootResponse, error) {
+	logging.FromContext(ctx).Debug("SquareRoot fun was invoked")
+
+	// get the request number
+	number := req.GetNumber()
+
+	// Input validation and return INVALID_ARGUMENT exception of input is negative
+	if number < 0 {
+		// use rpcerr package for error handling in grpc, it attaches google.rpc error details
+		// ErrorInfo carries a stable reason so clients can branch on it instead of parsing the message
+		// BadRequest tells the client which field of the request was invalid
+		return nil, rpcerr.InvalidArgument(
+			rpcerr.ReasonNegativeNumber,
+			fmt.Sprintf("Received a negative number: %v", number),
+			rpcerr.FieldViolation("number", "must not be negative"),
+		)
+	}
+
+	// If number is positive, return square root of number
+	return &calculatorv1.SquareRootResponse{
+		NumberRoot: math.Sqrt(float64(number)),
+	}, nil
+
+}
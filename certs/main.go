@@ -0,0 +1,262 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rahulsingh/go-grpc-examples/devca"
+)
+
+// certs manages the development certificates the servers and clients read from ssl/
+//
+//	certs init                              CA (if missing), server certificate and client certificate
+//	certs ca [--force]                      new CA: ca.crt, ca.key
+//	certs server [--san=localhost,127.0.0.1] server.crt, server.pem
+//	certs client [--name=client]            client.crt, client.pem for mutual TLS
+//	certs rotate [--ca]                     reissue every certificate, optionally with a new CA
+//	certs list                              certificates with their names and expiry
+//
+// Enable TLS afterwards with --server.tls.enabled and --client.tls.enabled,
+// and mutual TLS with --server.tls.client_ca_file=ssl/ca.crt --client.tls.cert_file=ssl/client.crt --client.tls.key_file=ssl/client.pem
+func main() {
+	log.SetFlags(0)
+
+	command := "init"
+	args := os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet("certs "+command, flag.ExitOnError)
+	dir := fs.String("dir", "ssl", "directory of the certificates")
+	validity := fs.Duration("validity", 365*24*time.Hour, "validity of server and client certificates")
+
+	var err error
+	switch command {
+	case "init":
+		sans := fs.String("san", defaultSANs, "comma separated subject alternative names of the server certificate")
+		client := fs.String("client", "client", "name of the client certificate, empty for none")
+		fs.Parse(args)
+		err = initAll(*dir, *sans, *client, *validity)
+	case "ca":
+		force := fs.Bool("force", false, "replace an existing CA, certificates it issued are no longer trusted")
+		caValidity := fs.Duration("ca-validity", defaultCAValidity, "validity of the CA certificate")
+		fs.Parse(args)
+		err = newCA(*dir, *force, *caValidity)
+	case "server":
+		name := fs.String("name", "server", "certificate name, written to <name>.crt and <name>.pem")
+		sans := fs.String("san", defaultSANs, "comma separated subject alternative names: DNS names, IPs, URIs")
+		fs.Parse(args)
+		err = issue(*dir, *name, *sans, devca.ServerAuth, *validity)
+	case "client":
+		name := fs.String("name", "client", "certificate name, written to <name>.crt and <name>.pem")
+		sans := fs.String("san", "", "comma separated subject alternative names, default spiffe://go-grpc-examples/<name>")
+		fs.Parse(args)
+		if *sans == "" {
+			*sans = clientURI(*name)
+		}
+		err = issue(*dir, *name, *sans, devca.ClientAuth, *validity)
+	case "rotate":
+		withCA := fs.Bool("ca", false, "also replace the CA, the previous one stays trusted in ca.crt until the next CA rotation")
+		caValidity := fs.Duration("ca-validity", defaultCAValidity, "validity of a new CA certificate")
+		fs.Parse(args)
+		err = rotate(*dir, *withCA, *caValidity, *validity)
+	case "list":
+		fs.Parse(args)
+		err = list(*dir)
+	default:
+		log.Fatalf("unknown command %q, use init, ca, server, client, rotate or list", command)
+	}
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+}
+
+// the server certificate matches how the clients reach a local server by default
+const defaultSANs = "localhost,127.0.0.1,::1"
+
+const defaultCAValidity = 10 * 365 * 24 * time.Hour
+
+// file names the servers and clients expect, see config.Default
+const (
+	caCertFile = "ca.crt"
+	caKeyFile  = "ca.key"
+	certExt    = ".crt"
+	keyExt     = ".pem"
+)
+
+func clientURI(name string) string {
+	return "spiffe://go-grpc-examples/" + name
+}
+
+// initAll creates whatever is needed to run with mutual TLS, keeping an existing CA
+func initAll(dir, sans, client string, validity time.Duration) error {
+	if _, err := os.Stat(filepath.Join(dir, caKeyFile)); os.IsNotExist(err) {
+		if err := newCA(dir, false, defaultCAValidity); err != nil {
+			return err
+		}
+	}
+	if err := issue(dir, "server", sans, devca.ServerAuth, validity); err != nil {
+		return err
+	}
+	if client == "" {
+		return nil
+	}
+	return issue(dir, client, clientURI(client), devca.ClientAuth, validity)
+}
+
+// newCA creates the CA, refusing to replace an existing one unless forced
+func newCA(dir string, force bool, validity time.Duration) error {
+	certFile, keyFile := filepath.Join(dir, caCertFile), filepath.Join(dir, caKeyFile)
+	if _, err := os.Stat(keyFile); err == nil && !force {
+		return fmt.Errorf("%v already exists, use --force to replace it or rotate --ca to keep trusting it during the switch", keyFile)
+	}
+	ca, err := devca.NewAuthority("go-grpc-examples dev CA", validity)
+	if err != nil {
+		return err
+	}
+	if err := ca.Save(certFile, keyFile); err != nil {
+		return err
+	}
+	fmt.Printf("Created CA %v valid until %v\n", certFile, ca.Cert.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// issue writes <name>.crt and <name>.pem signed by the CA of dir
+func issue(dir, name, sans string, usage devca.Usage, validity time.Duration) error {
+	ca, err := loadCA(dir)
+	if err != nil {
+		return err
+	}
+	opts := devca.LeafOptions{CommonName: name, Usage: usage, Validity: validity}
+	for _, san := range strings.Split(sans, ",") {
+		if err := opts.AddSAN(san); err != nil {
+			return err
+		}
+	}
+	return write(dir, name, ca, opts)
+}
+
+// rotate reissues every certificate of dir with a new key and the same names,
+// after replacing the CA if withCA is set
+func rotate(dir string, withCA bool, caValidity, validity time.Duration) error {
+	ca, err := loadCA(dir)
+	if err != nil {
+		return err
+	}
+	if withCA {
+		previous := ca
+		if ca, err = devca.NewAuthority(previous.Cert.Subject.CommonName, caValidity); err != nil {
+			return err
+		}
+		// peers that still present certificates of the previous CA keep being trusted until they are reissued
+		if err := ca.Save(filepath.Join(dir, caCertFile), filepath.Join(dir, caKeyFile), previous.Cert); err != nil {
+			return err
+		}
+		fmt.Printf("Rotated CA, valid until %v, the previous CA stays trusted\n", ca.Cert.NotAfter.Format(time.RFC3339))
+	}
+
+	leaves, err := leafNames(dir)
+	if err != nil {
+		return err
+	}
+	if len(leaves) == 0 {
+		fmt.Println("No certificate to rotate, create them with: certs init")
+		return nil
+	}
+	for _, name := range leaves {
+		certs, err := devca.ReadCertificates(filepath.Join(dir, name+certExt))
+		if err != nil {
+			return err
+		}
+		opts := devca.OptionsOf(certs[0], validity)
+		if opts.Usage == 0 {
+			fmt.Printf("Skipping %v: not a server or client certificate\n", name+certExt)
+			continue
+		}
+		if err := write(dir, name, ca, opts); err != nil {
+			return err
+		}
+	}
+	// servers with server.tls.reload_interval pick up the new files without restarting
+	return nil
+}
+
+// list prints the certificates of dir
+func list(dir string) error {
+	names, err := leafNames(dir)
+	if err != nil {
+		return err
+	}
+	files := append([]string{caCertFile}, names...)
+	for i, name := range files {
+		if i > 0 {
+			name += certExt
+		}
+		certs, err := devca.ReadCertificates(filepath.Join(dir, name))
+		if err != nil {
+			fmt.Printf("%-16v %v\n", name, err)
+			continue
+		}
+		for _, cert := range certs {
+			opts := devca.OptionsOf(cert, 0)
+			fmt.Printf("%-16v CN=%v expires %v SANs %v\n", name, cert.Subject.CommonName,
+				cert.NotAfter.Format(time.RFC3339), strings.Join(opts.SANs(), ","))
+		}
+	}
+	return nil
+}
+
+// loadCA reads the CA of dir
+func loadCA(dir string) (*devca.Authority, error) {
+	ca, err := devca.LoadAuthority(filepath.Join(dir, caCertFile), filepath.Join(dir, caKeyFile))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no CA in %v, create one with: certs ca", dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot load CA: %v", err)
+	}
+	return ca, nil
+}
+
+// write issues a certificate and stores it as <name>.crt and <name>.pem
+func write(dir, name string, ca *devca.Authority, opts devca.LeafOptions) error {
+	certPEM, keyPEM, err := ca.Issue(opts)
+	if err != nil {
+		return err
+	}
+	// key first: a server reloading in between fails to pair the new key with the old certificate
+	// and keeps its previous certificates until both files are written
+	if err := devca.WriteFile(filepath.Join(dir, name+keyExt), keyPEM, 0600); err != nil {
+		return err
+	}
+	if err := devca.WriteFile(filepath.Join(dir, name+certExt), certPEM, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Issued %v for %v\n", filepath.Join(dir, name+certExt), strings.Join(opts.SANs(), ","))
+	return nil
+}
+
+// leafNames returns the names of the certificates of dir other than the CA, sorted
+func leafNames(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+certExt))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, match := range matches {
+		base := filepath.Base(match)
+		if base == caCertFile {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(base, certExt))
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+)
+
+// the blog commands call blog.v2, blog.v1 is kept for the clients built before it
+var blogCommands = []command{
+	{name: "create", summary: "create a blog, a draft unless --state is given (CreateBlog)", setup: blogCreate},
+	{name: "get", args: "<blog-id>", summary: "show a blog (GetBlog)", setup: blogGet},
+	{name: "slug", args: "<slug>", summary: "show the blog with a slug (GetBlogBySlug)", setup: blogGetBySlug},
+	{name: "update", args: "<blog-id>", summary: "change the given fields of a blog (GetBlog, UpdateBlog)", setup: blogUpdate},
+	{name: "delete", args: "<blog-id>", summary: "delete a blog (DeleteBlog)", setup: blogDelete},
+	{name: "list", summary: "list the blogs, all or in one --state (ListBlogs)", setup: blogList},
+	{name: "popular", summary: "most viewed published blogs (ListPopularBlogs)", setup: blogPopular},
+	{name: "related", args: "<blog-id>", summary: "published blogs most similar to a blog (GetRelatedBlogs)", setup: blogRelated},
+}
+
+// blogColumns are the columns of a table of blogs, see blogRow
+var blogColumns = []string{"ID", "STATE", "VERSION", "AUTHOR", "TITLE", "VIEWS", "UPDATED"}
+
+// blogFlags are the flags of the editable fields of a blog
+type blogFlags struct {
+	author, title, content, state, tags, slug *string
+}
+
+func newBlogFlags(fs *flag.FlagSet) blogFlags {
+	return blogFlags{
+		author:  fs.String("author", "", "author id"),
+		title:   fs.String("title", "", "title"),
+		content: fs.String("content", "", "content, - to read it from stdin"),
+		state:   fs.String("state", "", "state: draft, published or archived"),
+		tags:    fs.String("tags", "", "comma separated tags, replacing those of the blog"),
+		slug:    fs.String("slug", "", "name of the blog in URLs, e.g. streaming-with-grpc"),
+	}
+}
+
+// apply sets the fields of blog given as flags
+func (f blogFlags) apply(blog *blogv2.Blog) error {
+	if *f.author != "" {
+		blog.AuthorId = *f.author
+	}
+	if *f.title != "" {
+		blog.Title = *f.title
+	}
+	if *f.content == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("cannot read the content from stdin: %v", err)
+		}
+		blog.Content = string(data)
+	} else if *f.content != "" {
+		blog.Content = *f.content
+	}
+	if *f.state != "" {
+		state, err := parseBlogState(*f.state)
+		if err != nil {
+			return err
+		}
+		blog.State = state
+	}
+	if *f.tags != "" {
+		blog.Tags = strings.Split(*f.tags, ",")
+	}
+	if *f.slug != "" {
+		blog.Slug = *f.slug
+	}
+	return nil
+}
+
+func blogCreate(fs *flag.FlagSet) call {
+	fields := newBlogFlags(fs)
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) > 0 {
+			return usagef("the fields of the blog are given as flags")
+		}
+		blog := &blogv2.Blog{}
+		if err := fields.apply(blog); err != nil {
+			return err
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).CreateBlog(ctx, &blogv2.CreateBlogRequest{Blog: blog})
+		if err != nil {
+			return err
+		}
+		return printBlog(out, res, res.GetBlog())
+	}
+}
+
+func blogGet(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		blogID, err := blogIDArg(args)
+		if err != nil {
+			return err
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).GetBlog(ctx, &blogv2.GetBlogRequest{BlogId: blogID})
+		if err != nil {
+			return err
+		}
+		return printBlog(out, res, res.GetBlog())
+	}
+}
+
+func blogGetBySlug(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected a slug")
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).GetBlogBySlug(ctx, &blogv2.GetBlogBySlugRequest{Slug: args[0]})
+		if err != nil {
+			return err
+		}
+		return printBlog(out, res, res.GetBlog())
+	}
+}
+
+func blogUpdate(fs *flag.FlagSet) call {
+	fields := newBlogFlags(fs)
+	version := fs.Int64("version", 0, "version the change is based on, the version read before the update if 0")
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		blogID, err := blogIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		// UpdateBlog replaces every editable field, the fields not given are those of the blog read first,
+		// which is not a view of the blog; the update is based on the version read,
+		// so it fails with ABORTED if the blog changes in between
+		client := blogv2.NewBlogServiceClient(cc)
+		current, err := client.GetBlog(ctx, &blogv2.GetBlogRequest{BlogId: blogID, SkipView: true})
+		if err != nil {
+			return err
+		}
+		blog := current.GetBlog()
+		if err := fields.apply(blog); err != nil {
+			return err
+		}
+		if *version != 0 {
+			blog.Version = *version
+		}
+
+		res, err := client.UpdateBlog(ctx, &blogv2.UpdateBlogRequest{Blog: blog})
+		if err != nil {
+			return err
+		}
+		return printBlog(out, res, res.GetBlog())
+	}
+}
+
+func blogDelete(fs *flag.FlagSet) call {
+	version := fs.Int64("version", 0, "fail with ABORTED if the blog changed since this version, 0 to delete any version")
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		blogID, err := blogIDArg(args)
+		if err != nil {
+			return err
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).DeleteBlog(ctx, &blogv2.DeleteBlogRequest{BlogId: blogID, Version: *version})
+		if err != nil {
+			return err
+		}
+		return out.print(res, []string{"DELETED"}, []string{res.GetBlogId()})
+	}
+}
+
+func blogList(fs *flag.FlagSet) call {
+	state := fs.String("state", "", "only the blogs in this state: draft, published or archived")
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) > 0 {
+			return usagef("unexpected arguments %v", args)
+		}
+		req := &blogv2.ListBlogsRequest{}
+		if *state != "" {
+			var err error
+			if req.State, err = parseBlogState(*state); err != nil {
+				return err
+			}
+		}
+		stream, err := blogv2.NewBlogServiceClient(cc).ListBlogs(ctx, req)
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := out.print(res, blogColumns, blogRow(res.GetBlog())); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func blogPopular(fs *flag.FlagSet) call {
+	window := fs.String("window", "all_time", "views counted over: all_time, day or week")
+	limit := fs.Int("limit", 0, "maximum number of blogs, the server default if 0")
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) > 0 {
+			return usagef("unexpected arguments %v", args)
+		}
+		value, ok := blogv2.PopularityWindow_value["POPULARITY_WINDOW_"+strings.ToUpper(*window)]
+		if !ok {
+			return usagef("--window %q must be all_time, day or week", *window)
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).ListPopularBlogs(ctx, &blogv2.ListPopularBlogsRequest{
+			Window: blogv2.PopularityWindow(value),
+			Limit:  int32(*limit),
+		})
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(res.GetBlogs()))
+		for _, popular := range res.GetBlogs() {
+			rows = append(rows, append([]string{fmt.Sprint(popular.GetWindowViews())}, blogRow(popular.GetBlog())...))
+		}
+		return out.print(res, append([]string{"WINDOW VIEWS"}, blogColumns...), rows...)
+	}
+}
+
+func blogRelated(fs *flag.FlagSet) call {
+	limit := fs.Int("limit", 0, "maximum number of blogs, the server default if 0")
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		blogID, err := blogIDArg(args)
+		if err != nil {
+			return err
+		}
+		res, err := blogv2.NewBlogServiceClient(cc).GetRelatedBlogs(ctx, &blogv2.GetRelatedBlogsRequest{
+			BlogId: blogID,
+			Limit:  int32(*limit),
+		})
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(res.GetBlogs()))
+		for _, related := range res.GetBlogs() {
+			rows = append(rows, append([]string{fmt.Sprintf("%.3f", related.GetScore())}, blogRow(related.GetBlog())...))
+		}
+		return out.print(res, append([]string{"SCORE"}, blogColumns...), rows...)
+	}
+}
+
+// printBlog prints the response res of a single blog, as a table of its fields followed by its content
+func printBlog(out *output, res proto.Message, blog *blogv2.Blog) error {
+	cells := blogRow(blog)
+	rows := make([][]string, 0, len(blogColumns)+4)
+	for i, column := range blogColumns {
+		rows = append(rows, []string{strings.ToLower(column), cells[i]})
+	}
+	rows = append(rows,
+		[]string{"created", timeCell(blog.GetCreateTime())},
+		[]string{"published", timeCell(blog.GetPublishTime())},
+		[]string{"tags", strings.Join(blog.GetTags(), ", ")},
+		[]string{"slug", blog.GetSlug()},
+	)
+	if err := out.print(res, []string{"FIELD", "VALUE"}, rows...); err != nil {
+		return err
+	}
+	// the content may span lines, which would break the table
+	return out.text(blog.GetContent())
+}
+
+// blogRow returns the cells of blog under blogColumns
+func blogRow(blog *blogv2.Blog) []string {
+	return []string{
+		blog.GetId(),
+		enumCell(blog.GetState(), "BLOG_STATE_"),
+		fmt.Sprint(blog.GetVersion()),
+		blog.GetAuthorId(),
+		blog.GetTitle(),
+		fmt.Sprint(blog.GetViewCount()),
+		timeCell(blog.GetUpdateTime()),
+	}
+}
+
+// parseBlogState returns the blog.v2 state named on the command line, e.g. draft
+func parseBlogState(name string) (blogv2.BlogState, error) {
+	value, ok := blogv2.BlogState_value["BLOG_STATE_"+strings.ToUpper(name)]
+	if !ok || value == int32(blogv2.BlogState_BLOG_STATE_UNSPECIFIED) {
+		return 0, usagef("state %q must be draft, published or archived", name)
+	}
+	return blogv2.BlogState(value), nil
+}
+
+func blogIDArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", usagef("expected a blog id")
+	}
+	return args[0], nil
+}
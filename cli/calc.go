@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+)
+
+// negative numbers follow -- so they are not taken for flags: cli calc sqrt -- -4
+var calcCommands = []command{
+	{name: "sum", args: "<a> <b>", summary: "add two numbers (Sum)", setup: calcSum},
+	{name: "factor", args: "<n>", summary: "prime factors of a number (PrimeNumberDecomposition)", setup: calcFactor},
+	{name: "average", args: "[numbers...]", summary: "average of the numbers, read from stdin if none are given (ComputeAverage)", setup: calcAverage},
+	{name: "max", args: "[numbers...]", summary: "running maximum of the numbers, read from stdin if none are given (FindMaximum)", setup: calcMax},
+	{name: "sqrt", args: "<n>", summary: "square root of a number, INVALID_ARGUMENT if negative (SquareRoot)", setup: calcSqrt},
+}
+
+func calcSum(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) != 2 {
+			return usagef("expected two numbers")
+		}
+		a, err := parseInt32(args[0])
+		if err != nil {
+			return err
+		}
+		b, err := parseInt32(args[1])
+		if err != nil {
+			return err
+		}
+		res, err := calculatorv1.NewCalculatorServiceClient(cc).Sum(ctx, &calculatorv1.SumRequest{FirstNumber: a, SecondNumber: b})
+		if err != nil {
+			return err
+		}
+		return out.print(res, []string{"SUM"}, []string{fmt.Sprint(res.GetSumResult())})
+	}
+}
+
+func calcFactor(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one number")
+		}
+		n, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return usagef("invalid number %q", args[0])
+		}
+		stream, err := calculatorv1.NewCalculatorServiceClient(cc).PrimeNumberDecomposition(ctx,
+			&calculatorv1.PrimeNumberDecompositionRequest{InputNumber: n})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := out.print(res, []string{"PRIME FACTOR"}, []string{fmt.Sprint(res.GetPrimeNumber())}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func calcAverage(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		numbers, err := numberSource(args)
+		if err != nil {
+			return err
+		}
+		stream, err := calculatorv1.NewCalculatorServiceClient(cc).ComputeAverage(ctx)
+		if err != nil {
+			return err
+		}
+		err = numbers(func(n int32) error {
+			return stream.Send(&calculatorv1.ComputeAverageRequest{Number: n})
+		})
+		// io.EOF from Send means the server ended the call, CloseAndRecv returns its status
+		if err != nil && err != io.EOF {
+			return err
+		}
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		return out.print(res, []string{"AVERAGE"}, []string{fmt.Sprint(res.GetAverage())})
+	}
+}
+
+func calcMax(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		numbers, err := numberSource(args)
+		if err != nil {
+			return err
+		}
+		stream, err := calculatorv1.NewCalculatorServiceClient(cc).FindMaximum(ctx)
+		if err != nil {
+			return err
+		}
+
+		// numbers are sent while the maximums are received, so a new maximum shows up as soon as it is typed
+		sent := make(chan error, 1)
+		go func() {
+			err := numbers(func(n int32) error {
+				return stream.Send(&calculatorv1.FindMaximumRequest{Number: n})
+			})
+			// closing the stream also after a read error lets the server end the call
+			closeErr := stream.CloseSend()
+			if err == nil || err == io.EOF {
+				err = closeErr
+			}
+			sent <- err
+		}()
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return <-sent
+			}
+			if err != nil {
+				return err
+			}
+			if err := out.print(res, []string{"MAXIMUM"}, []string{fmt.Sprint(res.GetMaximum())}); err != nil {
+				return err
+			}
+			if err := out.flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func calcSqrt(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one number")
+		}
+		n, err := parseInt32(args[0])
+		if err != nil {
+			return err
+		}
+		res, err := calculatorv1.NewCalculatorServiceClient(cc).SquareRoot(ctx, &calculatorv1.SquareRootRequest{Number: n})
+		if err != nil {
+			return err
+		}
+		return out.print(res, []string{"SQUARE ROOT"}, []string{fmt.Sprint(res.GetNumberRoot())})
+	}
+}
+
+// numberSource returns a function calling send with every number of args, or of stdin if args is empty
+// it stops at the first error of send
+func numberSource(args []string) (func(send func(int32) error) error, error) {
+	if len(args) == 0 {
+		return func(send func(int32) error) error {
+			scanner := bufio.NewScanner(os.Stdin)
+			scanner.Split(bufio.ScanWords)
+			for scanner.Scan() {
+				n, err := strconv.ParseInt(scanner.Text(), 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid number %q read from stdin", scanner.Text())
+				}
+				if err := send(int32(n)); err != nil {
+					return err
+				}
+			}
+			return scanner.Err()
+		}, nil
+	}
+
+	numbers := make([]int32, len(args))
+	for i, arg := range args {
+		n, err := parseInt32(arg)
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+	return func(send func(int32) error) error {
+		for _, n := range numbers {
+			if err := send(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// parseInt32 parses a number given on the command line
+func parseInt32(arg string) (int32, error) {
+	n, err := strconv.ParseInt(arg, 10, 32)
+	if err != nil {
+		return 0, usagef("invalid number %q", arg)
+	}
+	return int32(n), nil
+}
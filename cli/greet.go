@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+var greetCommands = []command{
+	{name: "hello", args: "<first-name> [last-name]", summary: "greet someone (Greet)", setup: greetHello},
+	{name: "many-times", args: "<first-name> [last-name]", summary: "greet someone over and over (GreetManyTimes)", setup: greetManyTimes},
+	{name: "long", args: "< names", summary: "greet the names read from stdin at once (LongGreet)", setup: greetLong},
+	{name: "everyone", args: "< names", summary: "greet every name read from stdin as it is read (GreetEveryone)", setup: greetEveryone},
+	{name: "deadline", args: "<first-name> [last-name]", summary: "greet someone slowly, see --client.timeout (GreetWithDeadLine)", setup: greetDeadline},
+}
+
+var greetColumns = []string{"RESULT"}
+
+func greetHello(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		greeting, err := greetingFromArgs(args)
+		if err != nil {
+			return err
+		}
+		res, err := greetv1.NewGreetServiceClient(cc).Greet(ctx, &greetv1.GreetRequest{Greeting: greeting})
+		if err != nil {
+			return err
+		}
+		return out.print(res, greetColumns, []string{res.GetResult()})
+	}
+}
+
+func greetManyTimes(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		greeting, err := greetingFromArgs(args)
+		if err != nil {
+			return err
+		}
+		stream, err := greetv1.NewGreetServiceClient(cc).GreetManyTimes(ctx, &greetv1.GreetManyTimesRequest{Greeting: greeting})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// the greetings are sent one by one, show them as they arrive
+			if err := out.print(res, greetColumns, []string{res.GetResult()}); err != nil {
+				return err
+			}
+			if err := out.flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func greetLong(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) > 0 {
+			return usagef("names are read from stdin, one per line")
+		}
+		stream, err := greetv1.NewGreetServiceClient(cc).LongGreet(ctx)
+		if err != nil {
+			return err
+		}
+		err = readGreetings(os.Stdin, func(greeting *greetv1.Greeting) error {
+			return stream.Send(&greetv1.LongGreetRequest{Greeting: greeting})
+		})
+		// io.EOF from Send means the server ended the call, CloseAndRecv returns its status
+		if err != nil && err != io.EOF {
+			return err
+		}
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		return out.print(res, greetColumns, []string{res.GetResult()})
+	}
+}
+
+func greetEveryone(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		if len(args) > 0 {
+			return usagef("names are read from stdin, one per line")
+		}
+		stream, err := greetv1.NewGreetServiceClient(cc).GreetEveryone(ctx)
+		if err != nil {
+			return err
+		}
+
+		// names are sent while the greetings are received, so typed names are greeted right away
+		sent := make(chan error, 1)
+		go func() {
+			err := readGreetings(os.Stdin, func(greeting *greetv1.Greeting) error {
+				return stream.Send(&greetv1.GreetEveryoneRequest{Greeting: greeting})
+			})
+			// closing the stream also after a read error lets the server end the call
+			closeErr := stream.CloseSend()
+			if err == nil || err == io.EOF {
+				err = closeErr
+			}
+			sent <- err
+		}()
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				// the server answers the end of the stream once all names were sent
+				return <-sent
+			}
+			if err != nil {
+				return err
+			}
+			if err := out.print(res, greetColumns, []string{res.GetResult()}); err != nil {
+				return err
+			}
+			if err := out.flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func greetDeadline(fs *flag.FlagSet) call {
+	return func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error {
+		greeting, err := greetingFromArgs(args)
+		if err != nil {
+			return err
+		}
+		res, err := greetv1.NewGreetServiceClient(cc).GreetWithDeadLine(ctx, &greetv1.GreetWithDeadLineRequest{Greeting: greeting})
+		if err != nil {
+			return err
+		}
+		return out.print(res, greetColumns, []string{res.GetResult()})
+	}
+}
+
+// greetingFromArgs returns the greeting of the name given on the command line
+func greetingFromArgs(args []string) (*greetv1.Greeting, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return nil, usagef("expected a first name and an optional last name")
+	}
+	greeting := &greetv1.Greeting{FirstName: args[0]}
+	if len(args) == 2 {
+		greeting.LastName = args[1]
+	}
+	return greeting, nil
+}
+
+// readGreetings calls send with the greeting of every line of r, first name then last name, skipping empty lines
+// it stops at the first error of send
+func readGreetings(r io.Reader, send func(*greetv1.Greeting) error) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		greeting := &greetv1.Greeting{FirstName: fields[0], LastName: strings.Join(fields[1:], " ")}
+		if err := send(greeting); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
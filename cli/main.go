@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/grpcclient"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// cli calls every method of the greet, calculator and blog services
+//
+//	cli greet hello Rahul Singh
+//	cli greet everyone < names.txt
+//	cli calc factor 120
+//	cli blog create --author=rahul --title=Hi --content=- < post.md
+//	cli blog list --state=draft --output=json
+//
+// Every command takes the settings of the clients as flags, environment variables or a config file:
+// --client.address, --client.tls.enabled, --client.auth.token or --client.auth.token_file, --client.timeout, ...
+// and --output=table or json for the format of the responses.
+func main() {
+	if len(os.Args) < 3 {
+		usage()
+		os.Exit(2)
+	}
+	service, name := os.Args[1], os.Args[2]
+	cmd, ok := find(service, name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", service+" "+name)
+		usage()
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("cli "+service+" "+name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: cli %v %v [flags] %v\n\n%v\n\nflags:\n", service, name, cmd.args, cmd.summary)
+		clientFlags(fs).PrintDefaults()
+	}
+	format := fs.String("output", "table", "format of the responses: table or json")
+	call := cmd.setup(fs)
+	cfg := config.Default()
+	args := config.MustLoadFlags(fs, &cfg, os.Args[3:])
+
+	out, err := newOutput(os.Stdout, *format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err := run(&cfg, call, out, args); err != nil {
+		var usageErr usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprintf(os.Stderr, "%v\n\n", err)
+			fs.Usage()
+			os.Exit(2)
+		}
+		printError(err)
+		os.Exit(1)
+	}
+}
+
+// run connects to the server and makes the call of a command with the positional arguments args
+// the call is cancelled on control+C and after client.timeout, if set
+func run(cfg *config.Config, call call, out *output, args []string) error {
+	// export the spans of the calls when tracing.exporter is set
+	stopTracing, err := grpcclient.SetupTracing(cfg)
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
+	cc, err := grpcclient.Dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if cfg.Client.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Client.Timeout)
+		defer cancel()
+	}
+
+	err = call(ctx, cc, out, args)
+	// print what was received before a stream failed
+	if flushErr := out.flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// command is one subcommand of a service, calling one of its methods
+type command struct {
+	name    string
+	args    string // positional arguments in the usage, e.g. <blog-id>
+	summary string
+	// setup registers the flags of the command on fs and returns the call to make once they are parsed
+	setup func(fs *flag.FlagSet) call
+}
+
+// call makes the grpc calls of a command on cc and prints the responses to out
+type call func(ctx context.Context, cc *grpc.ClientConn, out *output, args []string) error
+
+// services lists the commands of every service by the name used on the command line
+var services = map[string][]command{
+	"greet": greetCommands,
+	"calc":  calcCommands,
+	"blog":  blogCommands,
+}
+
+func find(service, name string) (command, bool) {
+	for _, cmd := range services[service] {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: cli <service> <command> [flags] [arguments]\n\ncommands:")
+	names := make([]string, 0, len(services))
+	for service := range services {
+		names = append(names, service)
+	}
+	sort.Strings(names)
+	for _, service := range names {
+		for _, cmd := range services[service] {
+			fmt.Fprintf(os.Stderr, "  %-44v %v\n", strings.TrimSpace(service+" "+cmd.name+" "+cmd.args), cmd.summary)
+		}
+	}
+	fmt.Fprintln(os.Stderr, "\nrun cli <service> <command> --help for the flags of a command")
+}
+
+// clientFlags returns the flags of fs but the settings of the servers, which the cli reads without using them
+func clientFlags(fs *flag.FlagSet) *flag.FlagSet {
+	shown := flag.NewFlagSet(fs.Name(), flag.ContinueOnError)
+	shown.SetOutput(fs.Output())
+	fs.VisitAll(func(f *flag.Flag) {
+		for _, prefix := range []string{"server.", "mongo.", "log."} {
+			if strings.HasPrefix(f.Name, prefix) {
+				return
+			}
+		}
+		shown.Var(f.Value, f.Name, f.Usage)
+	})
+	return shown
+}
+
+// usageError is an invalid command line, reported with the usage of the command
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...interface{}) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// printError writes err to stderr with the code and the details the services attach to their errors
+func printError(err error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "error: %v: %v\n", s.Code(), s.Message())
+	if reason := rpcerr.Reason(err); reason != "" {
+		fmt.Fprintf(os.Stderr, "  reason: %v\n", reason)
+	}
+	for _, violation := range rpcerr.FieldViolations(err) {
+		fmt.Fprintf(os.Stderr, "  %v: %v\n", violation.GetField(), violation.GetDescription())
+	}
+	if resource := rpcerr.Resource(err); resource != nil {
+		fmt.Fprintf(os.Stderr, "  resource: %v %v\n", resource.GetResourceType(), resource.GetResourceName())
+	}
+	if info := rpcerr.Info(err); info != nil {
+		keys := make([]string, 0, len(info.GetMetadata()))
+		for key := range info.GetMetadata() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(os.Stderr, "  %v: %v\n", key, info.GetMetadata()[key])
+		}
+	}
+	if delay, ok := rpcerr.RetryDelay(err); ok {
+		fmt.Fprintf(os.Stderr, "  retry in: %v\n", delay)
+	}
+}
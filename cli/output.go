@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// output prints the responses of a command, as a table or as one line of JSON per response message
+type output struct {
+	json    bool
+	w       io.Writer
+	table   *tabwriter.Writer
+	columns bool // the column names were printed
+}
+
+func newOutput(w io.Writer, format string) (*output, error) {
+	switch format {
+	case "table":
+		return &output{w: w, table: tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)}, nil
+	case "json":
+		return &output{json: true, w: w}, nil
+	default:
+		return nil, fmt.Errorf("--output %q must be table or json", format)
+	}
+}
+
+// print writes msg as JSON, or the rows showing it in the table, under columns printed before the first rows
+// the table is aligned and written on flush
+func (o *output) print(msg proto.Message, columns []string, rows ...[]string) error {
+	if o.json {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(o.w, "%s\n", data)
+		return err
+	}
+
+	if !o.columns {
+		o.columns = true
+		if _, err := fmt.Fprintln(o.table, strings.Join(columns, "\t")); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(o.table, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flush writes the rows of the table printed so far, commands answering as their input arrives flush every response
+func (o *output) flush() error {
+	if o.json {
+		return nil
+	}
+	return o.table.Flush()
+}
+
+// text writes s below the table printed so far, for values spanning lines such as the content of a blog
+// JSON responses hold them already
+func (o *output) text(s string) error {
+	if o.json || s == "" {
+		return nil
+	}
+	if err := o.table.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(o.w, "\n%v\n", strings.TrimRight(s, "\n"))
+	return err
+}
+
+// timeCell formats a time of a response, empty if it is not set
+func timeCell(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Local().Format(time.DateTime)
+}
+
+// enumCell formats an enum value without the prefix of its type, e.g. draft for BLOG_STATE_DRAFT
+func enumCell(value fmt.Stringer, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(value.String(), prefix))
+}
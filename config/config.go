@@ -0,0 +1,341 @@
+// Package config loads the settings shared by all server and client binaries.
+//
+// Settings are resolved in this order, later sources overriding earlier ones:
+// built-in defaults, the YAML or TOML config file, environment variables and command-line flags.
+// Every setting has a dotted key, e.g. server.address, which is also its flag name (--server.address)
+// and, upper-cased with EnvPrefix, its environment variable (GRPC_DEMO_SERVER_ADDRESS).
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// EnvPrefix is the prefix of all environment variables read by this package
+const EnvPrefix = "GRPC_DEMO_"
+
+// ConfigFileEnv names the environment variable holding the config file path, used when --config is not given
+const ConfigFileEnv = EnvPrefix + "CONFIG"
+
+// Service names accepted in server.services
+const (
+	ServiceGreet      = "greet"
+	ServiceCalculator = "calculator"
+	ServiceBlog       = "blog"
+)
+
+// Config holds every setting of the servers and clients
+type Config struct {
+	Server  ServerConfig  `yaml:"server" toml:"server"`
+	Log     LogConfig     `yaml:"log" toml:"log"`
+	Tracing TracingConfig `yaml:"tracing" toml:"tracing"`
+	Mongo   MongoConfig   `yaml:"mongo" toml:"mongo"`
+	Client  ClientConfig  `yaml:"client" toml:"client"`
+}
+
+// ServerConfig configures the grpc server and the services it hosts
+type ServerConfig struct {
+	Address   string          `yaml:"address" toml:"address" usage:"listen address of the grpc server"`
+	Services  []string        `yaml:"services" toml:"services" usage:"services to host: greet, calculator, blog"`
+	TLS       ServerTLSConfig `yaml:"tls" toml:"tls"`
+	Auth      AuthConfig      `yaml:"auth" toml:"auth"`
+	Metrics   MetricsConfig   `yaml:"metrics" toml:"metrics"`
+	HTTP      HTTPConfig      `yaml:"http" toml:"http"`
+	Limits    LimitsConfig    `yaml:"limits" toml:"limits"`
+	Deadlines DeadlinesConfig `yaml:"deadlines" toml:"deadlines"`
+
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" toml:"health_check_interval" usage:"how often the health of service dependencies, e.g. the blog store, is checked"`
+	ShutdownDelay       time.Duration `yaml:"shutdown_delay" toml:"shutdown_delay" usage:"how long a shutdown keeps serving after failing the health checks, until load balancers stop sending calls"`
+	ShutdownTimeout     time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" usage:"how long a shutdown waits for in-flight calls before cancelling them"`
+	Connect             bool          `yaml:"connect" toml:"connect" usage:"also accept Connect protocol calls, over HTTP/1.1 and HTTP/2, on server.address"`
+}
+
+// ServerTLSConfig configures TLS of the grpc server
+type ServerTLSConfig struct {
+	Enabled  bool   `yaml:"enabled" toml:"enabled" usage:"serve over TLS"`
+	CertFile string `yaml:"cert_file" toml:"cert_file" usage:"server certificate"`
+	KeyFile  string `yaml:"key_file" toml:"key_file" usage:"server private key"`
+
+	// mutual TLS: client certificates are verified against ClientCAFile when set
+	ClientCAFile      string        `yaml:"client_ca_file" toml:"client_ca_file" usage:"CA bundle verifying client certificates, enables mutual TLS"`
+	RequireClientCert bool          `yaml:"require_client_cert" toml:"require_client_cert" usage:"reject clients without a verified certificate"`
+	ReloadInterval    time.Duration `yaml:"reload_interval" toml:"reload_interval" usage:"how often certificate files are checked for changes, 0 disables reloading"`
+}
+
+// AuthConfig configures bearer token authentication of the grpc server
+// which methods need which scopes and roles is declared in grpcserver/policy.go
+type AuthConfig struct {
+	Enabled        bool     `yaml:"enabled" toml:"enabled" usage:"require JWT bearer tokens"`
+	HMACSecretFile string   `yaml:"hmac_secret_file" toml:"hmac_secret_file" usage:"shared secret verifying HS256 tokens"`
+	PublicKeyFiles []string `yaml:"public_key_files" toml:"public_key_files" usage:"PEM RSA or ECDSA public keys verifying RS/PS/ES tokens"`
+	JWKSFile       string   `yaml:"jwks_file" toml:"jwks_file" usage:"local JWKS file of keys verifying tokens by kid"`
+	Issuer         string   `yaml:"issuer" toml:"issuer" usage:"required iss claim, empty to accept any"`
+	Audience       string   `yaml:"audience" toml:"audience" usage:"required aud claim, empty to accept any"`
+}
+
+// MetricsConfig configures the HTTP endpoint serving Prometheus metrics next to the grpc port
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled" toml:"enabled" usage:"serve Prometheus metrics over HTTP"`
+	Address string `yaml:"address" toml:"address" usage:"listen address of the metrics endpoint"`
+	Path    string `yaml:"path" toml:"path" usage:"HTTP path of the metrics endpoint"`
+}
+
+// HTTPConfig configures the HTTP listener serving the REST/JSON gateway and gRPC-Web calls of the services
+// it uses the TLS settings of the grpc server
+type HTTPConfig struct {
+	Enabled     bool     `yaml:"enabled" toml:"enabled" usage:"serve the REST/JSON gateway over HTTP"`
+	Address     string   `yaml:"address" toml:"address" usage:"listen address of the HTTP server"`
+	GRPCWeb     bool     `yaml:"grpc_web" toml:"grpc_web" usage:"also accept gRPC-Web calls from browsers on the HTTP server"`
+	CORSOrigins []string `yaml:"cors_origins" toml:"cors_origins" usage:"origins of the browser apps allowed to call the HTTP server, * for any"`
+}
+
+// LimitsConfig configures the rate and concurrency limits of the grpc server
+type LimitsConfig struct {
+	Enabled bool     `yaml:"enabled" toml:"enabled" usage:"reject calls over the limits with RESOURCE_EXHAUSTED"`
+	Rules   []string `yaml:"rules" toml:"rules" usage:"limits per method, e.g. '/blog.v1.BlogService/ListBlog caller_rate=2 caller_burst=5 max_streams=20'"`
+}
+
+// DeadlinesConfig configures the deadlines the grpc server applies to calls
+type DeadlinesConfig struct {
+	Default time.Duration `yaml:"default" toml:"default" usage:"deadline of calls sent without one, 0 for none"`
+	Max     time.Duration `yaml:"max" toml:"max" usage:"longest deadline a call may run with, 0 for no bound"`
+	Rules   []string      `yaml:"rules" toml:"rules" usage:"deadlines per method, e.g. '/blog.v1.BlogService/ListBlog default=5m max=30m'"`
+}
+
+// LogConfig configures the structured logs of the servers
+type LogConfig struct {
+	Level    string   `yaml:"level" toml:"level" usage:"log level: debug, info, warn or error"`
+	Format   string   `yaml:"format" toml:"format" usage:"log format: json or text"`
+	Payloads bool     `yaml:"payloads" toml:"payloads" usage:"log request and response messages"`
+	Redact   []string `yaml:"redact" toml:"redact" usage:"message fields hidden in logged payloads"`
+}
+
+// TracingConfig configures OpenTelemetry tracing of the servers and clients
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" toml:"exporter" usage:"span exporter: none, stdout or otlp"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" toml:"otlp_endpoint" usage:"host:port of the OTLP grpc receiver"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" toml:"otlp_insecure" usage:"connect to the OTLP receiver without TLS"`
+	SampleRatio  float64 `yaml:"sample_ratio" toml:"sample_ratio" usage:"fraction of new traces recorded, 0 to 1"`
+	ServiceName  string  `yaml:"service_name" toml:"service_name" usage:"service.name of the spans, the binary name if empty"`
+}
+
+// MongoConfig configures the mongodb store of the blog service
+type MongoConfig struct {
+	URI            string `yaml:"uri" toml:"uri" secret:"password" usage:"mongodb connection string"`
+	Database       string `yaml:"database" toml:"database" usage:"mongodb database of the blog service"`
+	BlogCollection string `yaml:"blog_collection" toml:"blog_collection" usage:"collection storing blogs"`
+	ViewCollection string `yaml:"view_collection" toml:"view_collection" usage:"collection storing hourly blog views"`
+	MigrateOnStart bool   `yaml:"migrate_on_start" toml:"migrate_on_start" usage:"apply pending schema migrations at startup"`
+}
+
+// ClientConfig configures how the client binaries reach the server
+type ClientConfig struct {
+	Address string           `yaml:"address" toml:"address" usage:"address of the grpc server"`
+	TLS     ClientTLSConfig  `yaml:"tls" toml:"tls"`
+	Auth    ClientAuthConfig `yaml:"auth" toml:"auth"`
+
+	Timeout time.Duration `yaml:"timeout" toml:"timeout" usage:"deadline of every call, 0 for none"`
+	Verbose bool          `yaml:"verbose" toml:"verbose" usage:"report how the connection is set up on stderr, e.g. a token sent without TLS"`
+}
+
+// ClientTLSConfig configures TLS of the clients
+type ClientTLSConfig struct {
+	Enabled    bool   `yaml:"enabled" toml:"enabled" usage:"connect over TLS"`
+	CAFile     string `yaml:"ca_file" toml:"ca_file" usage:"certificate authority trust certificate"`
+	ServerName string `yaml:"server_name" toml:"server_name" usage:"override of the server name verified in its certificate"`
+	CertFile   string `yaml:"cert_file" toml:"cert_file" usage:"client certificate presented for mutual TLS"`
+	KeyFile    string `yaml:"key_file" toml:"key_file" usage:"client private key"`
+}
+
+// ClientAuthConfig configures the bearer token sent by the clients
+type ClientAuthConfig struct {
+	Token     string `yaml:"token" toml:"token" secret:"true" usage:"JWT bearer token sent with every call"`
+	TokenFile string `yaml:"token_file" toml:"token_file" usage:"file holding the JWT bearer token"`
+}
+
+// Default returns the settings the demo binaries have always used
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Address:  "0.0.0.0:50051",
+			Services: []string{ServiceGreet, ServiceCalculator, ServiceBlog},
+			TLS: ServerTLSConfig{
+				CertFile:       "ssl/server.crt",
+				KeyFile:        "ssl/server.pem",
+				ReloadInterval: 30 * time.Second,
+			},
+			Auth: AuthConfig{
+				Issuer:   "go-grpc-examples",
+				Audience: "go-grpc-examples",
+			},
+			Metrics: MetricsConfig{
+				Address: "0.0.0.0:9090",
+				Path:    "/metrics",
+			},
+			HTTP: HTTPConfig{
+				Address: "0.0.0.0:8080",
+			},
+			// the methods a single client can keep the server busy with
+			Limits: LimitsConfig{
+				Rules: []string{
+					"/calculator.v1.CalculatorService/PrimeNumberDecomposition caller_rate=5 caller_burst=10 max_streams=50",
+					"/blog.v1.BlogService/ListBlog caller_rate=2 caller_burst=5 max_streams=20",
+					"/blog.v2.BlogService/ListBlogs caller_rate=2 caller_burst=5 max_streams=20",
+				},
+			},
+			Deadlines: DeadlinesConfig{
+				Default: 30 * time.Second,
+				Max:     5 * time.Minute,
+				// streaming all blogs of a large store takes longer
+				Rules: []string{
+					"/blog.v1.BlogService/ListBlog default=5m max=30m",
+					"/blog.v2.BlogService/ListBlogs default=5m max=30m",
+				},
+			},
+			HealthCheckInterval: 5 * time.Second,
+			ShutdownDelay:       5 * time.Second,
+			ShutdownTimeout:     30 * time.Second,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+			Redact: []string{"author_id", "content"},
+		},
+		Tracing: TracingConfig{
+			Exporter:     "none",
+			OTLPEndpoint: "localhost:4317",
+			OTLPInsecure: true,
+			SampleRatio:  1,
+		},
+		Mongo: MongoConfig{
+			URI:            "mongodb://localhost:27017",
+			Database:       "mydb",
+			BlogCollection: "blog",
+			ViewCollection: "blog_views",
+			MigrateOnStart: true,
+		},
+		Client: ClientConfig{
+			Address: "localhost:50051",
+			TLS: ClientTLSConfig{
+				CAFile: "ssl/ca.crt",
+			},
+		},
+	}
+}
+
+// Enabled reports whether the named service is listed in server.services
+func (s ServerConfig) Enabled(service string) bool {
+	for _, name := range s.Services {
+		if name == service {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the settings and reports all problems found
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	// server
+	_, _, err := net.SplitHostPort(c.Server.Address)
+	check(err == nil, "server.address %q must be host:port", c.Server.Address)
+	check(len(c.Server.Services) > 0, "server.services must name at least one service")
+	for _, name := range c.Server.Services {
+		check(name == ServiceGreet || name == ServiceCalculator || name == ServiceBlog,
+			"server.services: unknown service %q", name)
+	}
+	if c.Server.TLS.Enabled {
+		check(c.Server.TLS.CertFile != "", "server.tls.cert_file is required when TLS is enabled")
+		check(c.Server.TLS.KeyFile != "", "server.tls.key_file is required when TLS is enabled")
+		check(!c.Server.TLS.RequireClientCert || c.Server.TLS.ClientCAFile != "",
+			"server.tls.client_ca_file is required to verify client certificates")
+		check(c.Server.TLS.ReloadInterval >= 0, "server.tls.reload_interval must not be negative")
+	} else {
+		check(!c.Server.TLS.RequireClientCert, "server.tls.require_client_cert needs server.tls.enabled")
+	}
+	if c.Server.HTTP.Enabled {
+		_, _, err := net.SplitHostPort(c.Server.HTTP.Address)
+		check(err == nil, "server.http.address %q must be host:port", c.Server.HTTP.Address)
+		check(c.Server.HTTP.Address != c.Server.Address, "server.http.address must differ from server.address")
+		for _, origin := range c.Server.HTTP.CORSOrigins {
+			u, err := url.Parse(origin)
+			check(origin == "*" || (err == nil && u.Scheme != "" && u.Host != "" && u.Path == ""),
+				"server.http.cors_origins %q must be * or scheme://host[:port]", origin)
+			// gRPC-Web always allows credentials, any origin could make calls with the cookies and certificate of the user
+			check(origin != "*" || !c.Server.HTTP.GRPCWeb,
+				"server.http.cors_origins * cannot be used with server.http.grpc_web, list the origins")
+		}
+	}
+	check(c.Server.HealthCheckInterval > 0, "server.health_check_interval must be positive")
+	check(c.Server.ShutdownDelay >= 0, "server.shutdown_delay must not be negative")
+	check(c.Server.ShutdownTimeout >= 0, "server.shutdown_timeout must not be negative")
+	check(c.Server.Deadlines.Default >= 0 && c.Server.Deadlines.Max >= 0, "server.deadlines must not be negative")
+	if c.Server.Auth.Enabled {
+		check(c.Server.Auth.HMACSecretFile != "" || len(c.Server.Auth.PublicKeyFiles) > 0 || c.Server.Auth.JWKSFile != "",
+			"server.auth needs hmac_secret_file, public_key_files or jwks_file when enabled")
+	}
+
+	if c.Server.Metrics.Enabled {
+		_, _, err := net.SplitHostPort(c.Server.Metrics.Address)
+		check(err == nil, "server.metrics.address %q must be host:port", c.Server.Metrics.Address)
+		check(c.Server.Metrics.Address != c.Server.Address, "server.metrics.address must differ from server.address")
+		check(strings.HasPrefix(c.Server.Metrics.Path, "/"), "server.metrics.path %q must start with /", c.Server.Metrics.Path)
+	}
+
+	// log
+	check(oneOf(strings.ToLower(c.Log.Level), "debug", "info", "warn", "error"),
+		"log.level %q must be debug, info, warn or error", c.Log.Level)
+	check(oneOf(strings.ToLower(c.Log.Format), "json", "text"), "log.format %q must be json or text", c.Log.Format)
+
+	// tracing
+	check(oneOf(c.Tracing.Exporter, "none", "stdout", "otlp"), "tracing.exporter %q must be none, stdout or otlp", c.Tracing.Exporter)
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1")
+	if c.Tracing.Exporter == "otlp" {
+		_, _, err := net.SplitHostPort(c.Tracing.OTLPEndpoint)
+		check(err == nil, "tracing.otlp_endpoint %q must be host:port", c.Tracing.OTLPEndpoint)
+	}
+
+	// mongo is only used by the blog service
+	if c.Server.Enabled(ServiceBlog) {
+		check(strings.HasPrefix(c.Mongo.URI, "mongodb://") || strings.HasPrefix(c.Mongo.URI, "mongodb+srv://"),
+			"mongo.uri %q must start with mongodb:// or mongodb+srv://", c.Mongo.URI)
+		check(c.Mongo.Database != "", "mongo.database must not be empty")
+		check(c.Mongo.BlogCollection != "", "mongo.blog_collection must not be empty")
+		check(c.Mongo.ViewCollection != "", "mongo.view_collection must not be empty")
+		check(c.Mongo.BlogCollection != c.Mongo.ViewCollection, "mongo.blog_collection and mongo.view_collection must differ")
+	}
+
+	// client
+	_, _, err = net.SplitHostPort(c.Client.Address)
+	check(err == nil, "client.address %q must be host:port", c.Client.Address)
+	if c.Client.TLS.Enabled {
+		check(c.Client.TLS.CAFile != "", "client.tls.ca_file is required when TLS is enabled")
+		check((c.Client.TLS.CertFile == "") == (c.Client.TLS.KeyFile == ""),
+			"client.tls.cert_file and client.tls.key_file must be set together")
+	}
+	check(c.Client.Auth.Token == "" || c.Client.Auth.TokenFile == "", "client.auth.token and client.auth.token_file are exclusive")
+	check(c.Client.Timeout >= 0, "client.timeout must not be negative")
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  %v", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
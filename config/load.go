@@ -0,0 +1,315 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// setting is one leaf field of Config with its dotted key
+type setting struct {
+	key   string // e.g. server.tls.enabled
+	usage string
+	value reflect.Value
+	// secret is the secret tag of the field: true if the value is hidden by Print,
+	// password if only the password of the URL it holds is
+	secret string
+}
+
+// env returns the environment variable of the setting, e.g. GRPC_DEMO_SERVER_TLS_ENABLED
+func (s setting) env() string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(s.key, ".", "_"))
+}
+
+// set parses raw according to the field type and stores it
+func (s setting) set(raw string) error {
+	switch s.value.Interface().(type) {
+	case string:
+		s.value.SetString(raw)
+	case bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%v: %v", s.key, err)
+		}
+		s.value.SetBool(b)
+	case int:
+		i, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("%v: %v", s.key, err)
+		}
+		s.value.SetInt(int64(i))
+	case float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("%v: %v", s.key, err)
+		}
+		s.value.SetFloat(f)
+	case time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%v: %v", s.key, err)
+		}
+		s.value.SetInt(int64(d))
+	case []string:
+		var list []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		s.value.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("%v: unsupported setting type %v", s.key, s.value.Type())
+	}
+	return nil
+}
+
+// redact replaces the value of a secret string setting, empty values are kept to show they are not set
+func (s setting) redact() {
+	value, ok := s.value.Interface().(string)
+	if !ok || value == "" {
+		return
+	}
+	switch s.secret {
+	case "true":
+		s.value.SetString(redacted)
+	case "password":
+		u, err := url.Parse(value)
+		if err != nil {
+			// cannot tell where the password is
+			s.value.SetString(redacted)
+			return
+		}
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+			s.value.SetString(u.String())
+		}
+	}
+}
+
+// settings lists the leaf fields of cfg in declaration order
+func settings(cfg *Config) []setting {
+	var all []setting
+	var walk func(prefix string, v reflect.Value)
+	walk = func(prefix string, v reflect.Value) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			key := prefix + name
+			if field.Type.Kind() == reflect.Struct {
+				walk(key+".", v.Field(i))
+				continue
+			}
+			all = append(all, setting{key: key, usage: field.Tag.Get("usage"), value: v.Field(i), secret: field.Tag.Get("secret")})
+		}
+	}
+	walk("", reflect.ValueOf(cfg).Elem())
+	return all
+}
+
+// rawFlag records the value of a command-line flag so it can be applied after the file and environment
+type rawFlag struct {
+	value  string
+	set    bool
+	isBool bool // allows --server.tls.enabled without =true
+}
+
+func (f *rawFlag) String() string { return f.value }
+
+func (f *rawFlag) IsBoolFlag() bool { return f.isBool }
+
+func (f *rawFlag) Set(value string) error {
+	f.value = value
+	f.set = true
+	return nil
+}
+
+// Loader registers the config flags on a FlagSet and builds the effective configuration after parsing.
+// Binaries may register their own flags on the same FlagSet.
+type Loader struct {
+	cfg         *Config
+	configFile  *string
+	printConfig *bool
+	flags       map[string]*rawFlag
+}
+
+// NewLoader registers --config, --print-config and one flag per setting of cfg on fs
+// cfg holds the defaults of the binary and receives the effective configuration on Load
+func NewLoader(fs *flag.FlagSet, cfg *Config) *Loader {
+	l := &Loader{
+		cfg:         cfg,
+		configFile:  fs.String("config", "", "YAML or TOML config file (env "+ConfigFileEnv+")"),
+		printConfig: fs.Bool("print-config", false, "print the effective configuration and exit"),
+		flags:       map[string]*rawFlag{},
+	}
+	for _, s := range settings(cfg) {
+		_, isBool := s.value.Interface().(bool)
+		f := &rawFlag{value: fmt.Sprint(s.value.Interface()), isBool: isBool}
+		if list, ok := s.value.Interface().([]string); ok {
+			f.value = strings.Join(list, ",")
+		}
+		l.flags[s.key] = f
+		fs.Var(f, s.key, fmt.Sprintf("%v (env %v)", s.usage, s.env()))
+	}
+	return l
+}
+
+// Load applies the config file, the environment and the parsed flags on top of the defaults and validates the result
+// fs must have been parsed
+func (l *Loader) Load() error {
+	path := *l.configFile
+	if path == "" {
+		path = os.Getenv(ConfigFileEnv)
+	}
+	if path != "" {
+		if err := loadFile(path, l.cfg); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range settings(l.cfg) {
+		if raw, ok := os.LookupEnv(s.env()); ok {
+			if err := s.set(raw); err != nil {
+				return fmt.Errorf("environment %v: %v", s.env(), err)
+			}
+		}
+		if f := l.flags[s.key]; f.set {
+			if err := s.set(f.value); err != nil {
+				return fmt.Errorf("flag --%v", err)
+			}
+		}
+	}
+
+	return l.cfg.Validate()
+}
+
+// PrintRequested reports whether --print-config was given
+func (l *Loader) PrintRequested() bool {
+	return *l.printConfig
+}
+
+// loadFile reads a YAML (.yaml, .yml) or TOML (.toml) file into cfg, keeping defaults of missing keys
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot read config file: %v", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && err != io.EOF {
+			return fmt.Errorf("cannot parse config file %v: %v", path, err)
+		}
+	case ".toml":
+		meta, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return fmt.Errorf("cannot parse config file %v: %v", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("config file %v: unknown keys %v", path, undecoded)
+		}
+	default:
+		return fmt.Errorf("config file %v: unsupported format, use .yaml, .yml or .toml", path)
+	}
+	return nil
+}
+
+// redacted replaces the secret settings in a printed configuration
+const redacted = "REDACTED"
+
+// Print writes the configuration as YAML, with the settings tagged secret redacted
+func Print(w io.Writer, cfg *Config) error {
+	printed := *cfg
+	for _, s := range settings(&printed) {
+		s.redact()
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&printed); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// MustLoad parses the command line of a binary into cfg, which holds its defaults.
+// It exits the program on invalid configuration and after printing it for --print-config.
+// It returns the positional arguments left after the flags.
+func MustLoad(cfg *Config) []string {
+	fs := flag.NewFlagSet(filepath.Base(os.Args[0]), flag.ExitOnError)
+	return MustLoadFlags(fs, cfg, os.Args[1:])
+}
+
+// MustLoadFlags is MustLoad parsing args with fs, on which the binary may have registered its own flags
+// flags may also follow the positional arguments
+func MustLoadFlags(fs *flag.FlagSet, cfg *Config, args []string) []string {
+	loader := NewLoader(fs, cfg)
+	fs.Parse(flagsFirst(fs, args))
+
+	err := loader.Load()
+	if loader.PrintRequested() {
+		// print even an invalid configuration, it helps to find the bad setting
+		if printErr := Print(os.Stdout, cfg); printErr != nil {
+			fmt.Fprintln(os.Stderr, printErr)
+			os.Exit(1)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if loader.PrintRequested() {
+		os.Exit(0)
+	}
+	return fs.Args()
+}
+
+// flagsFirst moves the flags of args before the positional arguments, which the flag package stops at,
+// so that flags may follow them, e.g. cli blog update <blog-id> --title=Hi. Arguments after -- stay positional
+func flagsFirst(fs *flag.FlagSet, args []string) []string {
+	var flags, positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if len(arg) < 2 || arg[0] != '-' {
+			positional = append(positional, arg)
+			continue
+		}
+		flags = append(flags, arg)
+		// the value of a non-boolean flag given as --name value is the next argument
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			continue
+		}
+		if f := fs.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flags = append(flags, args[i])
+		}
+	}
+	return append(append(flags, "--"), positional...)
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlagsFirst(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("title", "", "")
+	fs.Bool("verbose", false, "")
+	fs.Int("version", 0, "")
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"none", nil, []string{"--"}},
+		{"flags first already", []string{"--title=Hi", "id"}, []string{"--title=Hi", "--", "id"}},
+		{"flags after arguments", []string{"update", "id", "--title=Hi"}, []string{"--title=Hi", "--", "update", "id"}},
+		{"value as next argument", []string{"id", "--title", "Hi", "--version", "3"},
+			[]string{"--title", "Hi", "--version", "3", "--", "id"}},
+		{"single dash", []string{"id", "-title", "Hi"}, []string{"-title", "Hi", "--", "id"}},
+		{"bool flag takes no value", []string{"--verbose", "id"}, []string{"--verbose", "--", "id"}},
+		{"bool flag with value", []string{"id", "--verbose=false"}, []string{"--verbose=false", "--", "id"}},
+		{"unknown flag takes no value", []string{"--nope", "id"}, []string{"--nope", "--", "id"}},
+		{"flag value at the end missing", []string{"id", "--title"}, []string{"--title", "--", "id"}},
+		{"double dash ends flags", []string{"id", "--", "--title=Hi", "x"}, []string{"--", "id", "--title=Hi", "x"}},
+		{"lone dash is an argument", []string{"-", "--title=Hi"}, []string{"--title=Hi", "--", "-"}},
+		{"value looking like a flag", []string{"--title", "--verbose", "id"}, []string{"--title", "--verbose", "--", "id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flagsFirst(fs, tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("flagsFirst(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+
+	// the rearranged arguments parse to the flags and arguments given
+	if err := fs.Parse(flagsFirst(fs, []string{"update", "id", "--title", "Hi", "--verbose", "-", "--", "--version"})); err != nil {
+		t.Fatal(err)
+	}
+	if title := fs.Lookup("title").Value.String(); title != "Hi" {
+		t.Errorf("title %q, want Hi", title)
+	}
+	if verbose := fs.Lookup("verbose").Value.String(); verbose != "true" {
+		t.Errorf("verbose %q, want true", verbose)
+	}
+	if want := []string{"update", "id", "-", "--version"}; !reflect.DeepEqual(fs.Args(), want) {
+		t.Errorf("arguments %q, want %q", fs.Args(), want)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name      string
+		uri       string
+		token     string
+		wantURI   string
+		wantToken string
+	}{
+		{"password", "mongodb://admin:s3cret@db:27017/blogs?authSource=admin", "",
+			"mongodb://admin:REDACTED@db:27017/blogs?authSource=admin", ""},
+		{"user without password", "mongodb://admin@db:27017", "", "mongodb://admin@db:27017", ""},
+		{"no credentials", "mongodb://localhost:27017", "", "mongodb://localhost:27017", ""},
+		{"unparsable", "mongodb://admin:s3cret@db:port%", "", redacted, ""},
+		{"token", "", "eyJhbGciOiJIUzI1NiJ9.e30.sig", "", redacted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Mongo.URI = tt.uri
+			cfg.Client.Auth.Token = tt.token
+
+			var out bytes.Buffer
+			if err := Print(&out, &cfg); err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(out.String(), "s3cret") || tt.token != "" && strings.Contains(out.String(), tt.token) {
+				t.Errorf("printed secret:\n%v", out.String())
+			}
+
+			printed := Default()
+			if err := loadFileData(t, ".yaml", out.String(), &printed); err != nil {
+				t.Fatal(err)
+			}
+			if printed.Mongo.URI != tt.wantURI || printed.Client.Auth.Token != tt.wantToken {
+				t.Errorf("printed uri %q and token %q, want %q and %q",
+					printed.Mongo.URI, printed.Client.Auth.Token, tt.wantURI, tt.wantToken)
+			}
+			if cfg.Mongo.URI != tt.uri || cfg.Client.Auth.Token != tt.token {
+				t.Error("Print changed the configuration")
+			}
+		})
+	}
+}
+
+// loadFileData loads data, written to a file with the given extension, into cfg
+func loadFileData(t *testing.T, ext, data string, cfg *Config) error {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config"+ext)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return loadFile(path, cfg)
+}
+
+// TestLoad checks the precedence of the sources: defaults, then the file, then the environment, then flags
+func TestLoad(t *testing.T) {
+	file := `
+mongo:
+  database: from-file
+  blog_collection: from-file
+  view_collection: from-file
+client:
+  timeout: 3s
+`
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvPrefix+"MONGO_BLOG_COLLECTION", "from-env")
+	t.Setenv(EnvPrefix+"MONGO_VIEW_COLLECTION", "from-env")
+	t.Setenv(EnvPrefix+"SERVER_SERVICES", "greet, blog")
+
+	cfg := Default()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	loader := NewLoader(fs, &cfg)
+	if err := fs.Parse([]string{"--config", path, "--mongo.view_collection=from-flag", "--client.tls.enabled"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	defaults := Default()
+	if cfg.Server.Address != defaults.Server.Address {
+		t.Errorf("server.address %q, want the default %q", cfg.Server.Address, defaults.Server.Address)
+	}
+	if cfg.Mongo.Database != "from-file" || cfg.Client.Timeout != 3*time.Second {
+		t.Errorf("mongo.database %q and client.timeout %v, want the ones of the file", cfg.Mongo.Database, cfg.Client.Timeout)
+	}
+	if cfg.Mongo.BlogCollection != "from-env" {
+		t.Errorf("mongo.blog_collection %q, want the environment over the file", cfg.Mongo.BlogCollection)
+	}
+	if cfg.Mongo.ViewCollection != "from-flag" {
+		t.Errorf("mongo.view_collection %q, want the flag over the environment", cfg.Mongo.ViewCollection)
+	}
+	if want := []string{"greet", "blog"}; !reflect.DeepEqual(cfg.Server.Services, want) {
+		t.Errorf("server.services %q, want %q", cfg.Server.Services, want)
+	}
+	if !cfg.Client.TLS.Enabled {
+		t.Error("client.tls.enabled not set by the bool flag without value")
+	}
+}
+
+func TestLoadFileFromEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[mongo]\ndatabase = \"from-toml\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ConfigFileEnv, path)
+
+	cfg := Default()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	loader := NewLoader(fs, &cfg)
+	if err := fs.Parse(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Mongo.Database != "from-toml" {
+		t.Errorf("mongo.database %q, want the one of the file in %v", cfg.Mongo.Database, ConfigFileEnv)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		args    []string
+		wantErr string
+	}{
+		{"bad environment value", map[string]string{EnvPrefix + "CLIENT_TIMEOUT": "soon"}, nil,
+			"environment " + EnvPrefix + "CLIENT_TIMEOUT: client.timeout"},
+		{"bad flag value", nil, []string{"--server.tls.enabled=maybe"}, "flag --server.tls.enabled"},
+		{"invalid configuration", nil, []string{"--server.shutdown_timeout=-1s"}, "server.shutdown_timeout must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			cfg := Default()
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			loader := NewLoader(fs, &cfg)
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if err := loader.Load(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     string
+		data    string
+		wantErr string
+	}{
+		{"yaml", ".yaml", "mongo:\n  database: blogs\n", ""},
+		{"yml", ".yml", "mongo:\n  database: blogs\n", ""},
+		{"toml", ".toml", "[mongo]\ndatabase = \"blogs\"\n", ""},
+		{"empty yaml", ".yaml", "", ""},
+		{"unknown yaml key", ".yaml", "mongo:\n  databse: blogs\n", "databse"},
+		{"unknown toml key", ".toml", "[mongo]\ndatabse = \"blogs\"\n", "unknown keys"},
+		{"unsupported format", ".json", `{"mongo": {"database": "blogs"}}`, "unsupported format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			err := loadFileData(t, tt.ext, tt.data, &cfg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadFile error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.data != "" && cfg.Mongo.Database != "blogs" {
+				t.Errorf("mongo.database %q, want blogs", cfg.Mongo.Database)
+			}
+			if cfg.Server.Address != Default().Server.Address {
+				t.Errorf("server.address %q, want the default kept", cfg.Server.Address)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package deadline bounds how long the server works on a call: calls sent without a deadline get a default one,
+// and deadlines longer than a maximum are shortened, both per method.
+//
+// Handlers see the deadline in their context like one sent by the client, so store calls and loops
+// that honor the context stop in time. A handler blocked in Recv only notices once the next message arrives.
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Rule bounds the deadline of the calls of a method, zero values mean no bound
+type Rule struct {
+	Default time.Duration // deadline of calls sent without one
+	Max     time.Duration // longest deadline a call may run with
+}
+
+// Policy maps full method names to rules like auth.Policy does.
+// Keys are either a full method, e.g. /blog.v1.BlogService/ListBlog, or all methods of a service, e.g. /blog.v1.BlogService/*.
+type Policy map[string]Rule
+
+// ParsePolicy parses rules written as a method followed by default and/or max deadlines, separated by spaces, e.g.
+//
+//	/blog.v1.BlogService/ListBlog default=5m max=30m
+//
+// a bound not given in a rule is the one of fallback, 0 removes it
+func ParsePolicy(rules []string, fallback Rule) (Policy, error) {
+	policy := Policy{}
+	for _, s := range rules {
+		fields := strings.Fields(s)
+		if len(fields) < 2 || !strings.HasPrefix(fields[0], "/") {
+			return nil, fmt.Errorf("rule %q must be a method like /package.Service/Method followed by deadlines", s)
+		}
+		rule := fallback
+		for _, field := range fields[1:] {
+			name, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("rule %q: deadline %q must be name=duration", s, field)
+			}
+			d, err := time.ParseDuration(value)
+			if err != nil || d < 0 {
+				return nil, fmt.Errorf("rule %q: %v must be a positive duration or 0", s, name)
+			}
+			switch name {
+			case "default":
+				rule.Default = d
+			case "max":
+				rule.Max = d
+			default:
+				return nil, fmt.Errorf("rule %q: unknown deadline %q, use default or max", s, name)
+			}
+		}
+		policy[fields[0]] = rule
+	}
+	return policy, nil
+}
+
+// Enforcer applies the deadlines of a policy to the calls
+type Enforcer struct {
+	fallback Rule
+	policy   Policy
+}
+
+// New returns an enforcer applying the rules of policy, and fallback to the methods without a rule
+func New(fallback Rule, policy Policy) *Enforcer {
+	return &Enforcer{fallback: fallback, policy: policy}
+}
+
+// rule returns the rule of fullMethod, preferring an exact match over the service wildcard
+func (e *Enforcer) rule(fullMethod string) Rule {
+	if rule, ok := e.policy[fullMethod]; ok {
+		return rule
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		if rule, ok := e.policy[fullMethod[:i+1]+"*"]; ok {
+			return rule
+		}
+	}
+	return e.fallback
+}
+
+// context returns the context of a call with its deadline bounded by the rule of fullMethod
+func (e *Enforcer) context(ctx context.Context, fullMethod string) (context.Context, context.CancelFunc) {
+	rule := e.rule(fullMethod)
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok && rule.Default > 0:
+		// a default longer than the max is shortened like a deadline sent by the client
+		if rule.Max > 0 && rule.Default > rule.Max {
+			return context.WithTimeout(ctx, rule.Max)
+		}
+		return context.WithTimeout(ctx, rule.Default)
+	case rule.Max > 0 && (!ok || time.Until(deadline) > rule.Max):
+		return context.WithTimeout(ctx, rule.Max)
+	default:
+		return ctx, func() {}
+	}
+}
+
+// UnaryServerInterceptor bounds the deadline of unary calls
+func (e *Enforcer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := e.context(ctx, info.FullMethod)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor bounds the deadline of streaming calls
+func (e *Enforcer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := e.context(ss.Context(), info.FullMethod)
+		defer cancel()
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream gives handlers the context with the bounded deadline
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,108 @@
+package deadline
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePolicy(t *testing.T) {
+	fallback := Rule{Default: 30 * time.Second, Max: 5 * time.Minute}
+	tests := []struct {
+		name    string
+		rules   []string
+		want    Policy
+		wantErr string
+	}{
+		{"none", nil, Policy{}, ""},
+		{"both bounds", []string{"/blog.v1.BlogService/ListBlog default=5m max=30m"},
+			Policy{"/blog.v1.BlogService/ListBlog": {Default: 5 * time.Minute, Max: 30 * time.Minute}}, ""},
+		{"max inherits the fallback", []string{"/blog.v1.BlogService/* default=1m"},
+			Policy{"/blog.v1.BlogService/*": {Default: time.Minute, Max: 5 * time.Minute}}, ""},
+		{"default inherits the fallback", []string{"/greet.v1.GreetService/Greet max=10s"},
+			Policy{"/greet.v1.GreetService/Greet": {Default: 30 * time.Second, Max: 10 * time.Second}}, ""},
+		{"0 removes a bound", []string{"/greet.v1.GreetService/LongGreet default=0 max=0s"},
+			Policy{"/greet.v1.GreetService/LongGreet": {}}, ""},
+		{"later rule of a method wins", []string{"/greet.v1.GreetService/Greet max=10s", "/greet.v1.GreetService/Greet max=20s"},
+			Policy{"/greet.v1.GreetService/Greet": {Default: 30 * time.Second, Max: 20 * time.Second}}, ""},
+		{"no deadlines", []string{"/greet.v1.GreetService/Greet"}, nil, "must be a method"},
+		{"no method", []string{"default=1s"}, nil, "must be a method"},
+		{"no value", []string{"/greet.v1.GreetService/Greet max"}, nil, "must be name=duration"},
+		{"not a duration", []string{"/greet.v1.GreetService/Greet max=10"}, nil, "max must be a positive duration"},
+		{"negative", []string{"/greet.v1.GreetService/Greet default=-1s"}, nil, "default must be a positive duration"},
+		{"unknown bound", []string{"/greet.v1.GreetService/Greet timeout=1s"}, nil, `unknown deadline "timeout"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParsePolicy(tt.rules, fallback)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParsePolicy error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePolicy: %v", err)
+			}
+			if !reflect.DeepEqual(policy, tt.want) {
+				t.Errorf("ParsePolicy = %v, want %v", policy, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnforcerContext(t *testing.T) {
+	e := New(Rule{Default: 30 * time.Second, Max: 5 * time.Minute}, Policy{
+		"/blog.v1.BlogService/*":              {Default: time.Minute, Max: 10 * time.Minute},
+		"/blog.v1.BlogService/ListBlog":       {Max: time.Hour},
+		"/greet.v1.GreetService/LongGreet":    {},
+		"/greet.v1.GreetService/GreetForever": {Default: time.Hour, Max: time.Minute},
+	})
+	tests := []struct {
+		name   string
+		method string
+		client time.Duration // deadline sent by the client, none if 0
+		want   time.Duration // deadline of the handler, none if 0
+	}{
+		{"fallback default", "/greet.v1.GreetService/Greet", 0, 30 * time.Second},
+		{"fallback max", "/greet.v1.GreetService/Greet", time.Hour, 5 * time.Minute},
+		{"shorter client deadline kept", "/greet.v1.GreetService/Greet", 10 * time.Second, 10 * time.Second},
+		{"client deadline between default and max kept", "/greet.v1.GreetService/Greet", 2 * time.Minute, 2 * time.Minute},
+		{"wildcard default", "/blog.v1.BlogService/ReadBlog", 0, time.Minute},
+		{"wildcard max", "/blog.v1.BlogService/ReadBlog", time.Hour, 10 * time.Minute},
+		{"exact rule without default has no deadline", "/blog.v1.BlogService/ListBlog", 0, time.Hour},
+		{"exact rule max", "/blog.v1.BlogService/ListBlog", 2 * time.Hour, time.Hour},
+		{"no bounds", "/greet.v1.GreetService/LongGreet", 0, 0},
+		{"no bounds keep the client deadline", "/greet.v1.GreetService/LongGreet", 2 * time.Hour, 2 * time.Hour},
+		{"default longer than max", "/greet.v1.GreetService/GreetForever", 0, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.client > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.client)
+				defer cancel()
+			}
+			start := time.Now()
+			ctx, cancel := e.context(ctx, tt.method)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			if tt.want == 0 {
+				if ok {
+					t.Fatalf("deadline in %v, want none", time.Until(deadline))
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("no deadline, want %v", tt.want)
+			}
+			// the deadline is set between the start of the test and now
+			if earliest, latest := start.Add(tt.want-time.Second), time.Now().Add(tt.want); deadline.Before(earliest) || deadline.After(latest) {
+				t.Errorf("deadline in %v, want %v", deadline.Sub(start), tt.want)
+			}
+		})
+	}
+}
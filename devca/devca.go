@@ -0,0 +1,364 @@
+// Package devca is a small certificate authority for development: it creates a CA
+// and issues the server and client certificates used for TLS and mutual TLS.
+//
+// Keys are ECDSA P-256 stored unencrypted as PKCS#8 PEM, the format tls.LoadX509KeyPair reads.
+// It is meant for local setups and demos, not for production certificates.
+package devca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Organization is set on every certificate created by this package
+const Organization = "go-grpc-examples development CA"
+
+// clockSkew backdates certificates so that peers with slightly late clocks accept them right away
+const clockSkew = 5 * time.Minute
+
+// Authority is a CA able to issue certificates
+type Authority struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+}
+
+// NewAuthority creates a self-signed CA valid for validity
+func NewAuthority(commonName string, validity time.Duration) (*Authority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating CA key: %v", err)
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{Organization}},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(validity),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true, // the CA only signs leaf certificates
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		SubjectKeyId:          keyID(&key.PublicKey),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating CA certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &Authority{Cert: cert, Key: key}, nil
+}
+
+// LoadAuthority reads a CA from its certificate and key files
+// if certFile is a bundle, the first certificate is the CA, the others are trusted predecessors
+func LoadAuthority(certFile, keyFile string) (*Authority, error) {
+	certs, err := ReadCertificates(certFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := readKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cert := certs[0]
+	if !cert.IsCA {
+		return nil, fmt.Errorf("%v is not a CA certificate", certFile)
+	}
+	if !samePublicKey(cert.PublicKey, key.Public()) {
+		return nil, fmt.Errorf("the key in %v does not belong to the certificate in %v", keyFile, certFile)
+	}
+	return &Authority{Cert: cert, Key: key}, nil
+}
+
+// Save writes the CA certificate, followed by the trusted predecessors, and the CA key
+func (a *Authority) Save(certFile, keyFile string, predecessors ...*x509.Certificate) error {
+	bundle := encodeCert(a.Cert)
+	for _, cert := range predecessors {
+		bundle = append(bundle, encodeCert(cert)...)
+	}
+	keyPEM, err := encodeKey(a.Key)
+	if err != nil {
+		return err
+	}
+	if err := WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return err
+	}
+	return WriteFile(certFile, bundle, 0644)
+}
+
+// Usage selects what a leaf certificate may be used for
+type Usage int
+
+// Certificate usages, combine them with |
+const (
+	ServerAuth Usage = 1 << iota // TLS server, e.g. the grpc server
+	ClientAuth                   // TLS client presenting its certificate for mutual TLS
+)
+
+// LeafOptions describes a certificate to issue
+type LeafOptions struct {
+	CommonName string
+	DNSNames   []string
+	IPs        []net.IP
+	URIs       []*url.URL
+	Emails     []string
+	Usage      Usage
+	Validity   time.Duration
+}
+
+// AddSAN adds a subject alternative name, guessing its type:
+// IP addresses, URIs (containing ://), emails (containing @) and DNS names otherwise
+func (o *LeafOptions) AddSAN(san string) error {
+	san = strings.TrimSpace(san)
+	switch {
+	case san == "":
+		return nil
+	case net.ParseIP(san) != nil:
+		o.IPs = append(o.IPs, net.ParseIP(san))
+	case strings.Contains(san, "://"):
+		u, err := url.Parse(san)
+		if err != nil {
+			return fmt.Errorf("invalid URI SAN %q: %v", san, err)
+		}
+		o.URIs = append(o.URIs, u)
+	case strings.Contains(san, "@"):
+		o.Emails = append(o.Emails, san)
+	default:
+		o.DNSNames = append(o.DNSNames, san)
+	}
+	return nil
+}
+
+// SANs returns the subject alternative names of the options as strings
+func (o *LeafOptions) SANs() []string {
+	var sans []string
+	sans = append(sans, o.DNSNames...)
+	for _, ip := range o.IPs {
+		sans = append(sans, ip.String())
+	}
+	for _, u := range o.URIs {
+		sans = append(sans, u.String())
+	}
+	return append(sans, o.Emails...)
+}
+
+// OptionsOf returns the options that reissue cert with the same names and usage
+func OptionsOf(cert *x509.Certificate, validity time.Duration) LeafOptions {
+	opts := LeafOptions{
+		CommonName: cert.Subject.CommonName,
+		DNSNames:   cert.DNSNames,
+		IPs:        cert.IPAddresses,
+		URIs:       cert.URIs,
+		Emails:     cert.EmailAddresses,
+		Validity:   validity,
+	}
+	for _, usage := range cert.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			opts.Usage |= ServerAuth
+		case x509.ExtKeyUsageClientAuth:
+			opts.Usage |= ClientAuth
+		}
+	}
+	return opts
+}
+
+// Issue creates a new key and a certificate for it signed by the CA
+// it returns the PEM encoded certificate and key
+func (a *Authority) Issue(opts LeafOptions) (certPEM, keyPEM []byte, err error) {
+	if opts.Usage == 0 {
+		return nil, nil, fmt.Errorf("certificate %q needs a server or client usage", opts.CommonName)
+	}
+	if opts.Usage&ServerAuth != 0 && len(opts.DNSNames) == 0 && len(opts.IPs) == 0 {
+		// clients verify the server name against the SANs only, the common name is ignored
+		return nil, nil, fmt.Errorf("server certificate %q needs at least one DNS or IP SAN", opts.CommonName)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while generating key: %v", err)
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	notAfter := now.Add(opts.Validity)
+	if notAfter.After(a.Cert.NotAfter) {
+		// a certificate cannot outlive its CA
+		notAfter = a.Cert.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber:   serial,
+		Subject:        pkix.Name{CommonName: opts.CommonName, Organization: []string{Organization}},
+		NotBefore:      now.Add(-clockSkew),
+		NotAfter:       notAfter,
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		DNSNames:       opts.DNSNames,
+		IPAddresses:    opts.IPs,
+		URIs:           opts.URIs,
+		EmailAddresses: opts.Emails,
+		SubjectKeyId:   keyID(&key.PublicKey),
+	}
+	if opts.Usage&ServerAuth != 0 {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+	if opts.Usage&ClientAuth != 0 {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.Cert, &key.PublicKey, a.Key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while signing certificate %q: %v", opts.CommonName, err)
+	}
+	keyPEM, err = encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM, nil
+}
+
+// ReadCertificates reads all certificates of a PEM file
+func ReadCertificates(file string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in %v: %v", file, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in %v", file)
+	}
+	return certs, nil
+}
+
+// WriteFile replaces path atomically, so a server reloading its certificates never reads a half-written file
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// readKey reads an unencrypted PKCS#8, PKCS#1 or SEC 1 private key
+func readKey(file string) (crypto.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no private key found in %v", file)
+	}
+	if block.Headers["Proc-Type"] != "" {
+		return nil, fmt.Errorf("%v is encrypted, create a new CA with: certs ca --force", file)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in %v: %v", file, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key in %v", file)
+	}
+	return signer, nil
+}
+
+func encodeCert(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func encodeKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error while encoding private key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// newSerial returns a random 128 bit serial number
+func newSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("error while generating serial number: %v", err)
+	}
+	return serial, nil
+}
+
+// keyID is the subject key identifier of a public key: the SHA-1 of its encoding
+func keyID(pub crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil
+	}
+	sum := sha1.Sum(der)
+	return sum[:]
+}
+
+func samePublicKey(a, b crypto.PublicKey) bool {
+	type equaler interface{ Equal(crypto.PublicKey) bool }
+	eq, ok := a.(equaler)
+	return ok && eq.Equal(b)
+}
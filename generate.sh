@@ -1,9 +1,23 @@
 #!/bin/bash
-#command to generate grpc code from protobuf file greet.proto
-protoc greet/greetpb/greet.proto --go_out=plugins=grpc:.
+# generates the go code of the protos with buf, see buf.gen.yaml for the plugins to install, and needs jq
+# google/api/annotations.proto, imported for the REST gateway options, is vendored in third_party/googleapis
+set -e
+cd "$(dirname "$0")"
 
-#command to generate grpc code from protobuf file calculator.proto
-protoc calculator/calculatorpb/calculator.proto --go_out=plugins=grpc:.
+#lint the protos, see buf.yaml for the rules
+buf lint
 
-#command to generate grpc code from protobuf file blog.proto
-protoc blog/blogpb/blog.proto --go_out=plugins=grpc:.
\ No newline at end of file
+#fail on changes breaking generated code or clients of the protos on master, e.g. renamed fields or removed methods
+#BREAKING_AGAINST=none skips the check, e.g. for a change meant to break them
+if [ "${BREAKING_AGAINST:-master}" != none ]; then
+    buf breaking --against ".git#branch=${BREAKING_AGAINST:-master}"
+fi
+
+#generate messages, grpc code, REST gateway and OpenAPI document of the greet, calculator and blog protos, all versions
+buf generate
+
+#the operation id of openapi/config.yaml is given to every binding of a method, the PATCH binding of
+#blog.v2 UpdateBlog gets the id protoc-gen-openapiv2 gives additional bindings of methods without one
+jq --indent 2 '.paths["/v2/blogs/{blog.id}"].patch.operationId = "BlogServiceV2_UpdateBlog2"' \
+    openapi/api.swagger.json > openapi/api.swagger.json.tmp
+mv openapi/api.swagger.json.tmp openapi/api.swagger.json
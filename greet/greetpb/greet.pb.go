@@ -1,839 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// source: greet/greetpb/greet.proto
-
-package greetpb
-
-import (
-	context "context"
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	math "math"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
-
-// Greeting is a proto message
-type Greeting struct {
-	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Greeting) Reset()         { *m = Greeting{} }
-func (m *Greeting) String() string { return proto.CompactTextString(m) }
-func (*Greeting) ProtoMessage()    {}
-func (*Greeting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{0}
-}
-
-func (m *Greeting) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Greeting.Unmarshal(m, b)
-}
-func (m *Greeting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Greeting.Marshal(b, m, deterministic)
-}
-func (m *Greeting) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Greeting.Merge(m, src)
-}
-func (m *Greeting) XXX_Size() int {
-	return xxx_messageInfo_Greeting.Size(m)
-}
-func (m *Greeting) XXX_DiscardUnknown() {
-	xxx_messageInfo_Greeting.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Greeting proto.InternalMessageInfo
-
-func (m *Greeting) GetFirstName() string {
-	if m != nil {
-		return m.FirstName
-	}
-	return ""
-}
-
-func (m *Greeting) GetLastName() string {
-	if m != nil {
-		return m.LastName
-	}
-	return ""
-}
-
-// Request message for unary api
-type GreetRequest struct {
-	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *GreetRequest) Reset()         { *m = GreetRequest{} }
-func (m *GreetRequest) String() string { return proto.CompactTextString(m) }
-func (*GreetRequest) ProtoMessage()    {}
-func (*GreetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{1}
-}
-
-func (m *GreetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetRequest.Unmarshal(m, b)
-}
-func (m *GreetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetRequest.Marshal(b, m, deterministic)
-}
-func (m *GreetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetRequest.Merge(m, src)
-}
-func (m *GreetRequest) XXX_Size() int {
-	return xxx_messageInfo_GreetRequest.Size(m)
-}
-func (m *GreetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetRequest proto.InternalMessageInfo
-
-func (m *GreetRequest) GetGreeting() *Greeting {
-	if m != nil {
-		return m.Greeting
-	}
-	return nil
-}
-
-// Response message from unary api
-type GreetResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GreetResponse) Reset()         { *m = GreetResponse{} }
-func (m *GreetResponse) String() string { return proto.CompactTextString(m) }
-func (*GreetResponse) ProtoMessage()    {}
-func (*GreetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{2}
-}
-
-func (m *GreetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetResponse.Unmarshal(m, b)
-}
-func (m *GreetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetResponse.Marshal(b, m, deterministic)
-}
-func (m *GreetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetResponse.Merge(m, src)
-}
-func (m *GreetResponse) XXX_Size() int {
-	return xxx_messageInfo_GreetResponse.Size(m)
-}
-func (m *GreetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetResponse proto.InternalMessageInfo
-
-func (m *GreetResponse) GetResult() string {
-	if m != nil {
-		return m.Result
-	}
-	return ""
-}
-
-type GreetManyTimesRequest struct {
-	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *GreetManyTimesRequest) Reset()         { *m = GreetManyTimesRequest{} }
-func (m *GreetManyTimesRequest) String() string { return proto.CompactTextString(m) }
-func (*GreetManyTimesRequest) ProtoMessage()    {}
-func (*GreetManyTimesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{3}
-}
-
-func (m *GreetManyTimesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetManyTimesRequest.Unmarshal(m, b)
-}
-func (m *GreetManyTimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetManyTimesRequest.Marshal(b, m, deterministic)
-}
-func (m *GreetManyTimesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetManyTimesRequest.Merge(m, src)
-}
-func (m *GreetManyTimesRequest) XXX_Size() int {
-	return xxx_messageInfo_GreetManyTimesRequest.Size(m)
-}
-func (m *GreetManyTimesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetManyTimesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetManyTimesRequest proto.InternalMessageInfo
-
-func (m *GreetManyTimesRequest) GetGreeting() *Greeting {
-	if m != nil {
-		return m.Greeting
-	}
-	return nil
-}
-
-type GreetManyTimesResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GreetManyTimesResponse) Reset()         { *m = GreetManyTimesResponse{} }
-func (m *GreetManyTimesResponse) String() string { return proto.CompactTextString(m) }
-func (*GreetManyTimesResponse) ProtoMessage()    {}
-func (*GreetManyTimesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{4}
-}
-
-func (m *GreetManyTimesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetManyTimesResponse.Unmarshal(m, b)
-}
-func (m *GreetManyTimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetManyTimesResponse.Marshal(b, m, deterministic)
-}
-func (m *GreetManyTimesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetManyTimesResponse.Merge(m, src)
-}
-func (m *GreetManyTimesResponse) XXX_Size() int {
-	return xxx_messageInfo_GreetManyTimesResponse.Size(m)
-}
-func (m *GreetManyTimesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetManyTimesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetManyTimesResponse proto.InternalMessageInfo
-
-func (m *GreetManyTimesResponse) GetResult() string {
-	if m != nil {
-		return m.Result
-	}
-	return ""
-}
-
-type LongGreetRequest struct {
-	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *LongGreetRequest) Reset()         { *m = LongGreetRequest{} }
-func (m *LongGreetRequest) String() string { return proto.CompactTextString(m) }
-func (*LongGreetRequest) ProtoMessage()    {}
-func (*LongGreetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{5}
-}
-
-func (m *LongGreetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LongGreetRequest.Unmarshal(m, b)
-}
-func (m *LongGreetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LongGreetRequest.Marshal(b, m, deterministic)
-}
-func (m *LongGreetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LongGreetRequest.Merge(m, src)
-}
-func (m *LongGreetRequest) XXX_Size() int {
-	return xxx_messageInfo_LongGreetRequest.Size(m)
-}
-func (m *LongGreetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LongGreetRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_LongGreetRequest proto.InternalMessageInfo
-
-func (m *LongGreetRequest) GetGreeting() *Greeting {
-	if m != nil {
-		return m.Greeting
-	}
-	return nil
-}
-
-type LongGreetResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *LongGreetResponse) Reset()         { *m = LongGreetResponse{} }
-func (m *LongGreetResponse) String() string { return proto.CompactTextString(m) }
-func (*LongGreetResponse) ProtoMessage()    {}
-func (*LongGreetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{6}
-}
-
-func (m *LongGreetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LongGreetResponse.Unmarshal(m, b)
-}
-func (m *LongGreetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LongGreetResponse.Marshal(b, m, deterministic)
-}
-func (m *LongGreetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LongGreetResponse.Merge(m, src)
-}
-func (m *LongGreetResponse) XXX_Size() int {
-	return xxx_messageInfo_LongGreetResponse.Size(m)
-}
-func (m *LongGreetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LongGreetResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_LongGreetResponse proto.InternalMessageInfo
-
-func (m *LongGreetResponse) GetResult() string {
-	if m != nil {
-		return m.Result
-	}
-	return ""
-}
-
-type GreetEveryoneRequest struct {
-	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *GreetEveryoneRequest) Reset()         { *m = GreetEveryoneRequest{} }
-func (m *GreetEveryoneRequest) String() string { return proto.CompactTextString(m) }
-func (*GreetEveryoneRequest) ProtoMessage()    {}
-func (*GreetEveryoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{7}
-}
-
-func (m *GreetEveryoneRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetEveryoneRequest.Unmarshal(m, b)
-}
-func (m *GreetEveryoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetEveryoneRequest.Marshal(b, m, deterministic)
-}
-func (m *GreetEveryoneRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetEveryoneRequest.Merge(m, src)
-}
-func (m *GreetEveryoneRequest) XXX_Size() int {
-	return xxx_messageInfo_GreetEveryoneRequest.Size(m)
-}
-func (m *GreetEveryoneRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetEveryoneRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetEveryoneRequest proto.InternalMessageInfo
-
-func (m *GreetEveryoneRequest) GetGreeting() *Greeting {
-	if m != nil {
-		return m.Greeting
-	}
-	return nil
-}
-
-type GreetEveryoneResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GreetEveryoneResponse) Reset()         { *m = GreetEveryoneResponse{} }
-func (m *GreetEveryoneResponse) String() string { return proto.CompactTextString(m) }
-func (*GreetEveryoneResponse) ProtoMessage()    {}
-func (*GreetEveryoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{8}
-}
-
-func (m *GreetEveryoneResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetEveryoneResponse.Unmarshal(m, b)
-}
-func (m *GreetEveryoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetEveryoneResponse.Marshal(b, m, deterministic)
-}
-func (m *GreetEveryoneResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetEveryoneResponse.Merge(m, src)
-}
-func (m *GreetEveryoneResponse) XXX_Size() int {
-	return xxx_messageInfo_GreetEveryoneResponse.Size(m)
-}
-func (m *GreetEveryoneResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetEveryoneResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetEveryoneResponse proto.InternalMessageInfo
-
-func (m *GreetEveryoneResponse) GetResult() string {
-	if m != nil {
-		return m.Result
-	}
-	return ""
-}
-
-// Request message for unary api with Deadline
-type GreetWithDeadLineRequest struct {
-	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *GreetWithDeadLineRequest) Reset()         { *m = GreetWithDeadLineRequest{} }
-func (m *GreetWithDeadLineRequest) String() string { return proto.CompactTextString(m) }
-func (*GreetWithDeadLineRequest) ProtoMessage()    {}
-func (*GreetWithDeadLineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{9}
-}
-
-func (m *GreetWithDeadLineRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetWithDeadLineRequest.Unmarshal(m, b)
-}
-func (m *GreetWithDeadLineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetWithDeadLineRequest.Marshal(b, m, deterministic)
-}
-func (m *GreetWithDeadLineRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetWithDeadLineRequest.Merge(m, src)
-}
-func (m *GreetWithDeadLineRequest) XXX_Size() int {
-	return xxx_messageInfo_GreetWithDeadLineRequest.Size(m)
-}
-func (m *GreetWithDeadLineRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetWithDeadLineRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetWithDeadLineRequest proto.InternalMessageInfo
-
-func (m *GreetWithDeadLineRequest) GetGreeting() *Greeting {
-	if m != nil {
-		return m.Greeting
-	}
-	return nil
-}
-
-// Response message from unary api with Deadline
-type GreetWithDeadLineResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GreetWithDeadLineResponse) Reset()         { *m = GreetWithDeadLineResponse{} }
-func (m *GreetWithDeadLineResponse) String() string { return proto.CompactTextString(m) }
-func (*GreetWithDeadLineResponse) ProtoMessage()    {}
-func (*GreetWithDeadLineResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe6f881da19a2871, []int{10}
-}
-
-func (m *GreetWithDeadLineResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GreetWithDeadLineResponse.Unmarshal(m, b)
-}
-func (m *GreetWithDeadLineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GreetWithDeadLineResponse.Marshal(b, m, deterministic)
-}
-func (m *GreetWithDeadLineResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GreetWithDeadLineResponse.Merge(m, src)
-}
-func (m *GreetWithDeadLineResponse) XXX_Size() int {
-	return xxx_messageInfo_GreetWithDeadLineResponse.Size(m)
-}
-func (m *GreetWithDeadLineResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GreetWithDeadLineResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GreetWithDeadLineResponse proto.InternalMessageInfo
-
-func (m *GreetWithDeadLineResponse) GetResult() string {
-	if m != nil {
-		return m.Result
-	}
-	return ""
-}
-
-func init() {
-	proto.RegisterType((*Greeting)(nil), "greet.Greeting")
-	proto.RegisterType((*GreetRequest)(nil), "greet.GreetRequest")
-	proto.RegisterType((*GreetResponse)(nil), "greet.GreetResponse")
-	proto.RegisterType((*GreetManyTimesRequest)(nil), "greet.GreetManyTimesRequest")
-	proto.RegisterType((*GreetManyTimesResponse)(nil), "greet.GreetManyTimesResponse")
-	proto.RegisterType((*LongGreetRequest)(nil), "greet.LongGreetRequest")
-	proto.RegisterType((*LongGreetResponse)(nil), "greet.LongGreetResponse")
-	proto.RegisterType((*GreetEveryoneRequest)(nil), "greet.GreetEveryoneRequest")
-	proto.RegisterType((*GreetEveryoneResponse)(nil), "greet.GreetEveryoneResponse")
-	proto.RegisterType((*GreetWithDeadLineRequest)(nil), "greet.GreetWithDeadLineRequest")
-	proto.RegisterType((*GreetWithDeadLineResponse)(nil), "greet.GreetWithDeadLineResponse")
-}
-
-func init() { proto.RegisterFile("greet/greetpb/greet.proto", fileDescriptor_fe6f881da19a2871) }
-
-var fileDescriptor_fe6f881da19a2871 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0xf2, 0x40,
-	0x10, 0xc6, 0xdf, 0xbe, 0x06, 0xa4, 0xe3, 0x5f, 0x46, 0x44, 0x28, 0x10, 0x49, 0x2f, 0x92, 0x90,
-	0x00, 0x01, 0x6f, 0x1e, 0x4c, 0x10, 0xe5, 0x82, 0x46, 0xd1, 0x44, 0xe3, 0xc5, 0x14, 0x1d, 0x6b,
-	0x13, 0x68, 0xb1, 0x5d, 0x48, 0xf8, 0x04, 0x7e, 0x6d, 0xc3, 0xb2, 0x0b, 0xa5, 0x80, 0x4d, 0x7a,
-	0x69, 0xbb, 0xfb, 0x3c, 0xfb, 0x7b, 0xb6, 0x3b, 0x93, 0x85, 0xac, 0xe9, 0x12, 0xb1, 0x2a, 0x7f,
-	0x0e, 0x7b, 0xb3, 0x77, 0x65, 0xe8, 0x3a, 0xcc, 0xc1, 0x18, 0x1f, 0xe8, 0x37, 0x90, 0x68, 0x4f,
-	0x3f, 0x2c, 0xdb, 0xc4, 0x02, 0xc0, 0xa7, 0xe5, 0x7a, 0xec, 0xcd, 0x36, 0x06, 0x94, 0x51, 0x8a,
-	0x4a, 0x49, 0xed, 0xaa, 0x7c, 0xe6, 0xce, 0x18, 0x10, 0xe6, 0x40, 0xed, 0x1b, 0x52, 0xfd, 0xcf,
-	0xd5, 0xc4, 0x74, 0x62, 0x2a, 0xea, 0x17, 0xb0, 0xcb, 0x39, 0x5d, 0xfa, 0x1e, 0x91, 0xc7, 0xb0,
-	0x0c, 0x09, 0x53, 0x70, 0x39, 0x69, 0xa7, 0x7e, 0x50, 0x99, 0xc5, 0xcb, 0xb8, 0xee, 0xdc, 0xa0,
-	0x9f, 0xc1, 0x9e, 0x58, 0xec, 0x0d, 0x1d, 0xdb, 0x23, 0x4c, 0x43, 0xdc, 0x25, 0x6f, 0xd4, 0x67,
-	0x62, 0x17, 0x62, 0xa4, 0xb7, 0xe0, 0x98, 0x1b, 0x6f, 0x0d, 0x7b, 0xf2, 0x64, 0x0d, 0xc8, 0x8b,
-	0x14, 0x57, 0x83, 0x74, 0x90, 0x12, 0x92, 0x7b, 0x09, 0x87, 0x1d, 0xc7, 0x36, 0xa3, 0xff, 0x61,
-	0x19, 0x92, 0x3e, 0x40, 0x48, 0xda, 0x15, 0xa4, 0xb8, 0xf1, 0x7a, 0x4c, 0xee, 0xc4, 0xb1, 0x29,
-	0x52, 0x62, 0x55, 0x1c, 0xd5, 0x02, 0x12, 0x92, 0xda, 0x86, 0x0c, 0x5f, 0xf0, 0x6c, 0xb1, 0xaf,
-	0x16, 0x19, 0x1f, 0x1d, 0x2b, 0x62, 0x72, 0x03, 0xb2, 0x6b, 0x40, 0x7f, 0xa7, 0xd7, 0x7f, 0xb6,
-	0x44, 0x03, 0x3d, 0x92, 0x3b, 0xb6, 0xde, 0x09, 0xcf, 0x21, 0xc6, 0xc7, 0x78, 0xe4, 0x4f, 0x12,
-	0x1b, 0xd2, 0x52, 0xcb, 0x93, 0x33, 0xb8, 0xfe, 0x0f, 0x1f, 0x60, 0x7f, 0xb9, 0xb4, 0x98, 0xf7,
-	0x3b, 0x83, 0x7d, 0xa3, 0x15, 0x36, 0xa8, 0x12, 0x58, 0x53, 0xb0, 0x09, 0xea, 0xbc, 0x74, 0x78,
-	0x22, 0xfc, 0xc1, 0x6e, 0xd0, 0x32, 0xab, 0x82, 0x64, 0x94, 0x14, 0xbc, 0x17, 0x0d, 0x2e, 0x8b,
-	0x81, 0x39, 0x7f, 0x6e, 0xa0, 0xce, 0x5a, 0x7e, 0xbd, 0xb8, 0xe0, 0xd5, 0x14, 0x7c, 0x81, 0xe4,
-	0xca, 0x21, 0xe3, 0xa9, 0x7f, 0xe1, 0x9a, 0x3a, 0x6a, 0xc5, 0xcd, 0x06, 0x49, 0x6f, 0xaa, 0xaf,
-	0xdb, 0xe2, 0xbe, 0xe8, 0xc5, 0xf9, 0x55, 0xd1, 0xf8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x9f,
-	0x71, 0xee, 0x47, 0x04, 0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GreetServiceClient is the client API for GreetService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GreetServiceClient interface {
-	// Unary grpc API
-	Greet(ctx context.Context, in *GreetRequest, opts ...grpc.CallOption) (*GreetResponse, error)
-	// Server Streaming grpc API
-	GreetManyTimes(ctx context.Context, in *GreetManyTimesRequest, opts ...grpc.CallOption) (GreetService_GreetManyTimesClient, error)
-	// Client Streaming grpc API
-	LongGreet(ctx context.Context, opts ...grpc.CallOption) (GreetService_LongGreetClient, error)
-	// BiDi Streaming grpc API
-	GreetEveryone(ctx context.Context, opts ...grpc.CallOption) (GreetService_GreetEveryoneClient, error)
-	// Unary grpc API with Deadline
-	GreetWithDeadLine(ctx context.Context, in *GreetWithDeadLineRequest, opts ...grpc.CallOption) (*GreetWithDeadLineResponse, error)
-}
-
-type greetServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewGreetServiceClient(cc *grpc.ClientConn) GreetServiceClient {
-	return &greetServiceClient{cc}
-}
-
-func (c *greetServiceClient) Greet(ctx context.Context, in *GreetRequest, opts ...grpc.CallOption) (*GreetResponse, error) {
-	out := new(GreetResponse)
-	err := c.cc.Invoke(ctx, "/greet.GreetService/Greet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *greetServiceClient) GreetManyTimes(ctx context.Context, in *GreetManyTimesRequest, opts ...grpc.CallOption) (GreetService_GreetManyTimesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GreetService_serviceDesc.Streams[0], "/greet.GreetService/GreetManyTimes", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &greetServiceGreetManyTimesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type GreetService_GreetManyTimesClient interface {
-	Recv() (*GreetManyTimesResponse, error)
-	grpc.ClientStream
-}
-
-type greetServiceGreetManyTimesClient struct {
-	grpc.ClientStream
-}
-
-func (x *greetServiceGreetManyTimesClient) Recv() (*GreetManyTimesResponse, error) {
-	m := new(GreetManyTimesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *greetServiceClient) LongGreet(ctx context.Context, opts ...grpc.CallOption) (GreetService_LongGreetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GreetService_serviceDesc.Streams[1], "/greet.GreetService/LongGreet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &greetServiceLongGreetClient{stream}
-	return x, nil
-}
-
-type GreetService_LongGreetClient interface {
-	Send(*LongGreetRequest) error
-	CloseAndRecv() (*LongGreetResponse, error)
-	grpc.ClientStream
-}
-
-type greetServiceLongGreetClient struct {
-	grpc.ClientStream
-}
-
-func (x *greetServiceLongGreetClient) Send(m *LongGreetRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *greetServiceLongGreetClient) CloseAndRecv() (*LongGreetResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(LongGreetResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *greetServiceClient) GreetEveryone(ctx context.Context, opts ...grpc.CallOption) (GreetService_GreetEveryoneClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GreetService_serviceDesc.Streams[2], "/greet.GreetService/GreetEveryone", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &greetServiceGreetEveryoneClient{stream}
-	return x, nil
-}
-
-type GreetService_GreetEveryoneClient interface {
-	Send(*GreetEveryoneRequest) error
-	Recv() (*GreetEveryoneResponse, error)
-	grpc.ClientStream
-}
-
-type greetServiceGreetEveryoneClient struct {
-	grpc.ClientStream
-}
-
-func (x *greetServiceGreetEveryoneClient) Send(m *GreetEveryoneRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *greetServiceGreetEveryoneClient) Recv() (*GreetEveryoneResponse, error) {
-	m := new(GreetEveryoneResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *greetServiceClient) GreetWithDeadLine(ctx context.Context, in *GreetWithDeadLineRequest, opts ...grpc.CallOption) (*GreetWithDeadLineResponse, error) {
-	out := new(GreetWithDeadLineResponse)
-	err := c.cc.Invoke(ctx, "/greet.GreetService/GreetWithDeadLine", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// GreetServiceServer is the server API for GreetService service.
-type GreetServiceServer interface {
-	// Unary grpc API
-	Greet(context.Context, *GreetRequest) (*GreetResponse, error)
-	// Server Streaming grpc API
-	GreetManyTimes(*GreetManyTimesRequest, GreetService_GreetManyTimesServer) error
-	// Client Streaming grpc API
-	LongGreet(GreetService_LongGreetServer) error
-	// BiDi Streaming grpc API
-	GreetEveryone(GreetService_GreetEveryoneServer) error
-	// Unary grpc API with Deadline
-	GreetWithDeadLine(context.Context, *GreetWithDeadLineRequest) (*GreetWithDeadLineResponse, error)
-}
-
-// UnimplementedGreetServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedGreetServiceServer struct {
-}
-
-func (*UnimplementedGreetServiceServer) Greet(ctx context.Context, req *GreetRequest) (*GreetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Greet not implemented")
-}
-func (*UnimplementedGreetServiceServer) GreetManyTimes(req *GreetManyTimesRequest, srv GreetService_GreetManyTimesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GreetManyTimes not implemented")
-}
-func (*UnimplementedGreetServiceServer) LongGreet(srv GreetService_LongGreetServer) error {
-	return status.Errorf(codes.Unimplemented, "method LongGreet not implemented")
-}
-func (*UnimplementedGreetServiceServer) GreetEveryone(srv GreetService_GreetEveryoneServer) error {
-	return status.Errorf(codes.Unimplemented, "method GreetEveryone not implemented")
-}
-func (*UnimplementedGreetServiceServer) GreetWithDeadLine(ctx context.Context, req *GreetWithDeadLineRequest) (*GreetWithDeadLineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GreetWithDeadLine not implemented")
-}
-
-func RegisterGreetServiceServer(s *grpc.Server, srv GreetServiceServer) {
-	s.RegisterService(&_GreetService_serviceDesc, srv)
-}
-
-func _GreetService_Greet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GreetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreetServiceServer).Greet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greet.GreetService/Greet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreetServiceServer).Greet(ctx, req.(*GreetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GreetService_GreetManyTimes_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GreetManyTimesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GreetServiceServer).GreetManyTimes(m, &greetServiceGreetManyTimesServer{stream})
-}
-
-type GreetService_GreetManyTimesServer interface {
-	Send(*GreetManyTimesResponse) error
-	grpc.ServerStream
-}
-
-type greetServiceGreetManyTimesServer struct {
-	grpc.ServerStream
-}
-
-func (x *greetServiceGreetManyTimesServer) Send(m *GreetManyTimesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GreetService_LongGreet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GreetServiceServer).LongGreet(&greetServiceLongGreetServer{stream})
-}
-
-type GreetService_LongGreetServer interface {
-	SendAndClose(*LongGreetResponse) error
-	Recv() (*LongGreetRequest, error)
-	grpc.ServerStream
-}
-
-type greetServiceLongGreetServer struct {
-	grpc.ServerStream
-}
-
-func (x *greetServiceLongGreetServer) SendAndClose(m *LongGreetResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *greetServiceLongGreetServer) Recv() (*LongGreetRequest, error) {
-	m := new(LongGreetRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _GreetService_GreetEveryone_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GreetServiceServer).GreetEveryone(&greetServiceGreetEveryoneServer{stream})
-}
-
-type GreetService_GreetEveryoneServer interface {
-	Send(*GreetEveryoneResponse) error
-	Recv() (*GreetEveryoneRequest, error)
-	grpc.ServerStream
-}
-
-type greetServiceGreetEveryoneServer struct {
-	grpc.ServerStream
-}
-
-func (x *greetServiceGreetEveryoneServer) Send(m *GreetEveryoneResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *greetServiceGreetEveryoneServer) Recv() (*GreetEveryoneRequest, error) {
-	m := new(GreetEveryoneRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _GreetService_GreetWithDeadLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GreetWithDeadLineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreetServiceServer).GreetWithDeadLine(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greet.GreetService/GreetWithDeadLine",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreetServiceServer).GreetWithDeadLine(ctx, req.(*GreetWithDeadLineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _GreetService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greet.GreetService",
-	HandlerType: (*GreetServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Greet",
-			Handler:    _GreetService_Greet_Handler,
-		},
-		{
-			MethodName: "GreetWithDeadLine",
-			Handler:    _GreetService_GreetWithDeadLine_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GreetManyTimes",
-			Handler:       _GreetService_GreetManyTimes_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "LongGreet",
-			Handler:       _GreetService_LongGreet_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GreetEveryone",
-			Handler:       _GreetService_GreetEveryone_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "greet/greetpb/greet.proto",
-}
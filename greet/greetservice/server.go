@@ -0,0 +1,164 @@
+// Package greetservice implements the GreetService gRPC API
+package greetservice
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// Server implements greetv1.GreetServiceServer
+// methods added to the service answer UNIMPLEMENTED until Server implements them
+type Server struct {
+	greetv1.UnimplementedGreetServiceServer
+}
+
+// NewServer returns the GreetService implementation to register with a grpc.Server
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Greet is the implementation of Greet() rpc service functions on server struct from generated interface GreetServiceServer
+// Unary API server implementation
+func (s *Server) Greet(ctx context.Context, req *greetv1.GreetRequest) (*greetv1.GreetResponse, error) {
+	// the request itself is in the request log when log.payloads is enabled
+	logger := logging.FromContext(ctx)
+	logger.Debug("Greet fun was invoked")
+	// with mutual TLS the caller is identified by its verified client certificate
+	if id, ok := mtls.IdentityFromContext(ctx); ok {
+		logger.Info("Greet fun was invoked by a verified client", "caller", id.Name())
+	}
+	// Get the request data (first_name and last_name)
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
+
+	//prepare response message and return
+	result := "Hello " + firstName + " " + lastName
+	res := &greetv1.GreetResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
+
+// GreetManyTimes is the implementation of GreetManyTimes() rpc service function on server struct from interface GreetServiceServer
+// Server Streaming API server implementation
+func (s *Server) GreetManyTimes(req *greetv1.GreetManyTimesRequest, stream greetv1.GreetService_GreetManyTimesServer) error {
+	ctx := stream.Context()
+	logging.FromContext(ctx).Debug("GreetManyTimes fun was invoked")
+	// Get the request data (first_name and last_name)
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
+
+	//prepare stream response message and return as stream and finally if error return nil
+	for i := 0; i < 10; i++ {
+		result := "Hello " + firstName + " " + lastName + " -> number " + strconv.Itoa(i)
+		res := &greetv1.GreetManyTimesResponse{
+			Result: result,
+		}
+
+		// stream response, a client gone away ends the call
+		if err := stream.Send(res); err != nil {
+			return rpcerr.Stream(err, "error while sending stream response")
+		}
+		// sleep just to show stream response working, unless the client is gone or out of time
+		select {
+		case <-ctx.Done():
+			return rpcerr.Context(ctx, "GreetManyTimes was stopped")
+		case <-time.After(1000 * time.Millisecond):
+		}
+	}
+
+	// if no error
+	return nil
+
+}
+
+// LongGreet is the implementation of LongGreet() rpc service function on server struct from interface GreetServiceServer
+// Client Streaming API server implementation
+func (s *Server) LongGreet(stream greetv1.GreetService_LongGreetServer) error {
+	logging.FromContext(stream.Context()).Debug("LongGreet fun was invoked with a streaming request")
+	result := ""
+	for {
+		// Keep of fetching client stream request till it reaches end-of-file
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// we have finished reading the client request stream
+			// return the response on the same stream and close the stream
+			return stream.SendAndClose(&greetv1.LongGreetResponse{
+				Result: result,
+			})
+
+		}
+		if err != nil {
+			// the client canceled or broke the stream, only this call fails
+			return rpcerr.Stream(err, "error while reading client stream")
+		}
+
+		// Get the request data from client stream request and prepare the response
+		firstName := req.GetGreeting().GetFirstName()
+		lastName := req.GetGreeting().GetLastName()
+		result += "Hello " + firstName + " " + lastName + " ! "
+	}
+}
+
+// GreetEveryone is the implementation of GreetEveryone () rpc service function on server struct from interface GreetServiceServer
+// BiDi Streaming API server implementation
+func (s *Server) GreetEveryone(stream greetv1.GreetService_GreetEveryoneServer) error {
+	logging.FromContext(stream.Context()).Debug("GreetEveryone func was invoked with a streaming request")
+	// Iterate over all stream message request and send stream response for each request
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// done reading stream of request
+			return nil
+		}
+		if err != nil {
+			return rpcerr.Stream(err, "error while reading client stream")
+		}
+
+		// Read the request data and send response of each message of request stream
+		firstName := req.GetGreeting().GetFirstName()
+		result := "Hello " + firstName + "! "
+
+		// send stream response
+		sendErr := stream.Send(&greetv1.GreetEveryoneResponse{
+			Result: result,
+		})
+
+		if sendErr != nil {
+			return rpcerr.Stream(sendErr, "error while sending stream response")
+		}
+	}
+}
+
+// GreetWithDeadLine is the implementation of GreetWithDeadLine () rpc service function on server struct from interface GreetServiceServer
+// It is Deadline (timeout) rpc API server implementation
+func (s *Server) GreetWithDeadLine(ctx context.Context, req *greetv1.GreetWithDeadLineRequest) (*greetv1.GreetWithDeadLineResponse, error) {
+	logger := logging.FromContext(ctx)
+	logger.Debug("GreetWithDeadLine func was invoked")
+
+	// producing dealy for testing deadline
+	// sleep for 3 seconds, stopping as soon as the client cancels the request or its deadline expires
+	select {
+	case <-ctx.Done():
+		logger.Warn("The client has canceled the request or its deadline expired", "error", ctx.Err())
+		return nil, rpcerr.Context(ctx, "GreetWithDeadLine was stopped")
+	case <-time.After(3 * time.Second):
+	}
+
+	// prepare response and return
+	firstName := req.GetGreeting().GetFirstName()
+	result := "Hello " + firstName
+	res := &greetv1.GreetWithDeadLineResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
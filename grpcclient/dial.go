@@ -0,0 +1,90 @@
+// Package grpcclient creates the client connections of the client binaries from their configuration
+// it reports on stderr, stdout is left to the responses
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/rahulsingh/go-grpc-examples/auth"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+	"github.com/rahulsingh/go-grpc-examples/tracing"
+)
+
+// Dial creates a connection to the server at client.address
+// By default grpc uses SSL security, unless client.tls.enabled is set the connection is insecure
+// the connection is made on the first call, its errors are those of the call
+func Dial(cfg *config.Config) (*grpc.ClientConn, error) {
+	opts := grpc.WithTransportCredentials(insecure.NewCredentials())
+	if cfg.Client.TLS.Enabled {
+		// Creating a grpc client with SSL trust certificate
+		// and the client certificate if configured for mutual TLS
+		creds, err := mtls.ClientCredentials(mtls.ClientOptions{
+			CAFile:     cfg.Client.TLS.CAFile,
+			CertFile:   cfg.Client.TLS.CertFile,
+			KeyFile:    cfg.Client.TLS.KeyFile,
+			ServerName: cfg.Client.TLS.ServerName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// create cleint options to accept CA Trust Certificate
+		opts = grpc.WithTransportCredentials(creds)
+	}
+
+	// client spans of every call, their trace context is sent to the server
+	dialOpts := []grpc.DialOption{
+		opts,
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor()),
+	}
+	token := cfg.Client.Auth.Token
+	if cfg.Client.Auth.TokenFile != "" {
+		var err error
+		if token, err = auth.TokenFromFile(cfg.Client.Auth.TokenFile); err != nil {
+			return nil, err
+		}
+	}
+	if token != "" {
+		// the token is sent with every call, only over TLS unless TLS is disabled for the demo
+		if !cfg.Client.TLS.Enabled && cfg.Client.Verbose {
+			fmt.Fprintln(os.Stderr, "warning: sending the bearer token without TLS")
+		}
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.NewTokenCredentials(token, cfg.Client.TLS.Enabled)))
+	}
+
+	cc, err := grpc.NewClient(cfg.Client.Address, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to server: %v", err)
+	}
+	return cc, nil
+}
+
+// SetupTracing installs the tracer provider of a client binary according to the tracing settings
+// the returned function exports the spans still buffered, call it before the program exits
+func SetupTracing(cfg *config.Config) (func(), error) {
+	shutdown, err := tracing.Setup(tracing.Options{
+		ServiceName:  cfg.Tracing.ServiceName,
+		Exporter:     cfg.Tracing.Exporter,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: cfg.Tracing.OTLPInsecure,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "error while exporting spans: %v\n", err)
+		}
+	}, nil
+}
@@ -0,0 +1,105 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rahulsingh/go-grpc-examples/calculator/calculatorservice"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// startExampleServer serves the greet and calculator services with the default configuration
+func startExampleServer(t *testing.T) *testServer {
+	cfg := config.Default()
+	return startTestServer(t, &cfg, func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, greetservice.NewServer())
+		calculatorv1.RegisterCalculatorServiceServer(s, calculatorservice.NewServer())
+	})
+}
+
+// TestClientCancelsStream cancels the client streaming and bidirectional calls after their first message:
+// the client gets CANCELED, the handler returns and the server goes on serving
+func TestClientCancelsStream(t *testing.T) {
+	greeting := &greetv1.Greeting{FirstName: "Rahul", LastName: "Singh"}
+	tests := []struct {
+		method string
+		// call starts the call on conn, sends one message, cancels the call and returns the status it ends with
+		call func(ctx context.Context, cancel context.CancelFunc, conn *grpc.ClientConn) error
+	}{
+		{"LongGreet", func(ctx context.Context, cancel context.CancelFunc, conn *grpc.ClientConn) error {
+			stream, err := greetv1.NewGreetServiceClient(conn).LongGreet(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&greetv1.LongGreetRequest{Greeting: greeting}); err != nil {
+				return err
+			}
+			cancel()
+			_, err = stream.CloseAndRecv()
+			return err
+		}},
+		{"GreetEveryone", func(ctx context.Context, cancel context.CancelFunc, conn *grpc.ClientConn) error {
+			stream, err := greetv1.NewGreetServiceClient(conn).GreetEveryone(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&greetv1.GreetEveryoneRequest{Greeting: greeting}); err != nil {
+				return err
+			}
+			if _, err := stream.Recv(); err != nil {
+				return err
+			}
+			cancel()
+			_, err = stream.Recv()
+			return err
+		}},
+		{"ComputeAverage", func(ctx context.Context, cancel context.CancelFunc, conn *grpc.ClientConn) error {
+			stream, err := calculatorv1.NewCalculatorServiceClient(conn).ComputeAverage(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&calculatorv1.ComputeAverageRequest{Number: 4}); err != nil {
+				return err
+			}
+			cancel()
+			_, err = stream.CloseAndRecv()
+			return err
+		}},
+		{"FindMaximum", func(ctx context.Context, cancel context.CancelFunc, conn *grpc.ClientConn) error {
+			stream, err := calculatorv1.NewCalculatorServiceClient(conn).FindMaximum(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&calculatorv1.FindMaximumRequest{Number: 4}); err != nil {
+				return err
+			}
+			if _, err := stream.Recv(); err != nil {
+				return err
+			}
+			cancel()
+			_, err = stream.Recv()
+			return err
+		}},
+	}
+
+	server := startExampleServer(t)
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if err := tt.call(ctx, cancel, server.conn); status.Code(err) != codes.Canceled {
+				t.Errorf("cancelled call: %v, want CANCELED", err)
+			}
+			server.waitIdle(t, 2*time.Second)
+			server.checkServing(t)
+		})
+	}
+}
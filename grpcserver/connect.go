@@ -0,0 +1,332 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// connectServer serves native grpc and Connect calls on server.address, over HTTP/1.1 and HTTP/2, with or without TLS.
+// grpc calls go to the grpc server, Connect calls to handlers making the same call to the in-process grpc server,
+// so both run the same interceptors and handlers and fail with the same codes and details
+type connectServer struct {
+	grpc *grpc.Server
+	http *http.Server
+}
+
+// newConnectServer returns the server of s, and of the Connect handlers of the enabled services calling inproc
+func newConnectServer(cfg *config.Config, s *grpc.Server, inproc *inProcess) *connectServer {
+	mux := http.NewServeMux()
+	for _, procedure := range connectProcedures(cfg, inproc.conn) {
+		mux.Handle(procedure.name, procedure.handler)
+		// Connect clients built before versioning call the legacy name, it makes the same v1 call
+		if legacy, ok := legacyAlias(procedure.name); ok {
+			mux.Handle(legacy, procedure.handler)
+		}
+	}
+	connectHandler := inproc.forHTTP(mux)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPC(r) {
+			s.ServeHTTP(w, r)
+			return
+		}
+		connectHandler.ServeHTTP(w, r)
+	})
+
+	// grpc clients speak HTTP/2 without TLS, Connect clients may use HTTP/1.1 too
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	return &connectServer{grpc: s, http: &http.Server{Handler: handler, Protocols: protocols, ReadHeaderTimeout: 10 * time.Second}}
+}
+
+// isGRPC returns whether r is a native grpc call, gRPC-Web ones are left to the Connect handlers
+func isGRPC(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	return r.ProtoMajor == 2 && (contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+"))
+}
+
+// serve accepts connections on lis until the server is stopped
+func (c *connectServer) serve(lis net.Listener) error {
+	if err := c.http.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// GracefulStop closes the listener and waits for the calls in flight.
+// grpc.Server.GracefulStop cannot drain the calls it serves through ServeHTTP, the HTTP server does it instead
+func (c *connectServer) GracefulStop() {
+	c.http.Shutdown(context.Background())
+	c.grpc.Stop()
+}
+
+// Stop closes the listener and cancels the calls in flight
+func (c *connectServer) Stop() {
+	c.http.Close()
+	c.grpc.Stop()
+}
+
+// connectProcedure is the Connect handler of one method
+type connectProcedure struct {
+	name    string // full method, e.g. /greet.v1.GreetService/Greet
+	handler http.Handler
+}
+
+// connectProcedures returns the Connect handlers of the methods of the enabled services, calling them on conn
+func connectProcedures(cfg *config.Config, conn *grpc.ClientConn) []connectProcedure {
+	var procedures []connectProcedure
+	if cfg.Server.Enabled(config.ServiceGreet) {
+		procedures = append(procedures,
+			unaryProcedure[greetv1.GreetRequest, greetv1.GreetResponse](conn, greetv1.GreetService_Greet_FullMethodName),
+			serverStreamProcedure[greetv1.GreetManyTimesRequest, greetv1.GreetManyTimesResponse](conn, greetv1.GreetService_GreetManyTimes_FullMethodName),
+			clientStreamProcedure[greetv1.LongGreetRequest, greetv1.LongGreetResponse](conn, greetv1.GreetService_LongGreet_FullMethodName),
+			bidiStreamProcedure[greetv1.GreetEveryoneRequest, greetv1.GreetEveryoneResponse](conn, greetv1.GreetService_GreetEveryone_FullMethodName),
+			unaryProcedure[greetv1.GreetWithDeadLineRequest, greetv1.GreetWithDeadLineResponse](conn, greetv1.GreetService_GreetWithDeadLine_FullMethodName),
+		)
+	}
+	if cfg.Server.Enabled(config.ServiceCalculator) {
+		procedures = append(procedures,
+			unaryProcedure[calculatorv1.SumRequest, calculatorv1.SumResponse](conn, calculatorv1.CalculatorService_Sum_FullMethodName),
+			serverStreamProcedure[calculatorv1.PrimeNumberDecompositionRequest, calculatorv1.PrimeNumberDecompositionResponse](conn, calculatorv1.CalculatorService_PrimeNumberDecomposition_FullMethodName),
+			clientStreamProcedure[calculatorv1.ComputeAverageRequest, calculatorv1.ComputeAverageResponse](conn, calculatorv1.CalculatorService_ComputeAverage_FullMethodName),
+			bidiStreamProcedure[calculatorv1.FindMaximumRequest, calculatorv1.FindMaximumResponse](conn, calculatorv1.CalculatorService_FindMaximum_FullMethodName),
+			unaryProcedure[calculatorv1.SquareRootRequest, calculatorv1.SquareRootResponse](conn, calculatorv1.CalculatorService_SquareRoot_FullMethodName),
+		)
+	}
+	if cfg.Server.Enabled(config.ServiceBlog) {
+		procedures = append(procedures,
+			unaryProcedure[blogv1.CreateBlogRequest, blogv1.CreateBlogResponse](conn, blogv1.BlogService_CreateBlog_FullMethodName),
+			unaryProcedure[blogv1.ReadBlogRequest, blogv1.ReadBlogResponse](conn, blogv1.BlogService_ReadBlog_FullMethodName),
+			unaryProcedure[blogv1.UpdateBlogRequest, blogv1.UpdateBlogResponse](conn, blogv1.BlogService_UpdateBlog_FullMethodName),
+			unaryProcedure[blogv1.DeleteBlogRequest, blogv1.DeleteBlogResponse](conn, blogv1.BlogService_DeleteBlog_FullMethodName),
+			serverStreamProcedure[blogv1.ListBlogRequest, blogv1.ListBlogResponse](conn, blogv1.BlogService_ListBlog_FullMethodName),
+			unaryProcedure[blogv1.ListPopularBlogsRequest, blogv1.ListPopularBlogsResponse](conn, blogv1.BlogService_ListPopularBlogs_FullMethodName),
+			unaryProcedure[blogv1.GetRelatedBlogsRequest, blogv1.GetRelatedBlogsResponse](conn, blogv1.BlogService_GetRelatedBlogs_FullMethodName),
+			unaryProcedure[blogv2.CreateBlogRequest, blogv2.CreateBlogResponse](conn, blogv2.BlogService_CreateBlog_FullMethodName),
+			unaryProcedure[blogv2.GetBlogRequest, blogv2.GetBlogResponse](conn, blogv2.BlogService_GetBlog_FullMethodName),
+			unaryProcedure[blogv2.GetBlogBySlugRequest, blogv2.GetBlogBySlugResponse](conn, blogv2.BlogService_GetBlogBySlug_FullMethodName),
+			unaryProcedure[blogv2.UpdateBlogRequest, blogv2.UpdateBlogResponse](conn, blogv2.BlogService_UpdateBlog_FullMethodName),
+			unaryProcedure[blogv2.DeleteBlogRequest, blogv2.DeleteBlogResponse](conn, blogv2.BlogService_DeleteBlog_FullMethodName),
+			serverStreamProcedure[blogv2.ListBlogsRequest, blogv2.ListBlogsResponse](conn, blogv2.BlogService_ListBlogs_FullMethodName),
+			unaryProcedure[blogv2.ListPopularBlogsRequest, blogv2.ListPopularBlogsResponse](conn, blogv2.BlogService_ListPopularBlogs_FullMethodName),
+			unaryProcedure[blogv2.GetRelatedBlogsRequest, blogv2.GetRelatedBlogsResponse](conn, blogv2.BlogService_GetRelatedBlogs_FullMethodName),
+		)
+	}
+	return procedures
+}
+
+func unaryProcedure[Req, Res any](conn *grpc.ClientConn, method string) connectProcedure {
+	return connectProcedure{name: method, handler: connect.NewUnaryHandler(method,
+		func(ctx context.Context, req *connect.Request[Req]) (*connect.Response[Res], error) {
+			res := new(Res)
+			var header, trailer metadata.MD
+			err := conn.Invoke(outgoingContext(ctx, req.Header(), req.Peer()), method, req.Msg, res, grpc.Header(&header), grpc.Trailer(&trailer))
+			if err != nil {
+				return nil, connectError(err, header, trailer)
+			}
+			resp := connect.NewResponse(res)
+			copyMetadata(resp.Header(), header)
+			copyMetadata(resp.Trailer(), trailer)
+			return resp, nil
+		})}
+}
+
+func serverStreamProcedure[Req, Res any](conn *grpc.ClientConn, method string) connectProcedure {
+	desc := &grpc.StreamDesc{StreamName: method, ServerStreams: true}
+	return connectProcedure{name: method, handler: connect.NewServerStreamHandler(method,
+		func(ctx context.Context, req *connect.Request[Req], stream *connect.ServerStream[Res]) error {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			cs, err := conn.NewStream(outgoingContext(ctx, req.Header(), req.Peer()), desc, method)
+			if err != nil {
+				return connectError(err, nil, nil)
+			}
+			// io.EOF means the call already ended, its status is returned by RecvMsg
+			if err := cs.SendMsg(req.Msg); err != nil && err != io.EOF {
+				return connectError(err, nil, nil)
+			}
+			cs.CloseSend()
+			return forwardResponses(cs, stream.ResponseHeader(), stream.ResponseTrailer(), stream.Send)
+		})}
+}
+
+func clientStreamProcedure[Req, Res any](conn *grpc.ClientConn, method string) connectProcedure {
+	desc := &grpc.StreamDesc{StreamName: method, ClientStreams: true}
+	return connectProcedure{name: method, handler: connect.NewClientStreamHandler(method,
+		func(ctx context.Context, stream *connect.ClientStream[Req]) (*connect.Response[Res], error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			cs, err := conn.NewStream(outgoingContext(ctx, stream.RequestHeader(), stream.Peer()), desc, method)
+			if err != nil {
+				return nil, connectError(err, nil, nil)
+			}
+			for stream.Receive() {
+				if err := cs.SendMsg(stream.Msg()); err != nil {
+					if err == io.EOF {
+						break
+					}
+					return nil, connectError(err, nil, nil)
+				}
+			}
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			cs.CloseSend()
+
+			res := new(Res)
+			err = cs.RecvMsg(res)
+			header, _ := cs.Header()
+			if err != nil {
+				return nil, connectError(err, header, cs.Trailer())
+			}
+			resp := connect.NewResponse(res)
+			copyMetadata(resp.Header(), header)
+			copyMetadata(resp.Trailer(), cs.Trailer())
+			return resp, nil
+		})}
+}
+
+func bidiStreamProcedure[Req, Res any](conn *grpc.ClientConn, method string) connectProcedure {
+	desc := &grpc.StreamDesc{StreamName: method, ClientStreams: true, ServerStreams: true}
+	return connectProcedure{name: method, handler: connect.NewBidiStreamHandler(method,
+		func(ctx context.Context, stream *connect.BidiStream[Req, Res]) error {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			cs, err := conn.NewStream(outgoingContext(ctx, stream.RequestHeader(), stream.Peer()), desc, method)
+			if err != nil {
+				return connectError(err, nil, nil)
+			}
+			// requests are forwarded as they come, the call is cancelled if the client fails to send them
+			go func() {
+				for {
+					req, err := stream.Receive()
+					if errors.Is(err, io.EOF) {
+						cs.CloseSend()
+						return
+					}
+					if err != nil {
+						cancel()
+						return
+					}
+					if err := cs.SendMsg(req); err != nil {
+						return
+					}
+				}
+			}()
+			return forwardResponses(cs, stream.ResponseHeader(), stream.ResponseTrailer(), stream.Send)
+		})}
+}
+
+// forwardResponses sends the responses of cs with send until the call ends, and returns its status
+// the grpc headers and trailers of the call go to header and trailer
+func forwardResponses[Res any](cs grpc.ClientStream, header, trailer http.Header, send func(*Res) error) error {
+	sentHeader := false
+	for {
+		res := new(Res)
+		err := cs.RecvMsg(res)
+		if !sentHeader {
+			md, _ := cs.Header()
+			copyMetadata(header, md)
+			sentHeader = true
+		}
+		if err != nil {
+			copyMetadata(trailer, cs.Trailer())
+			if err == io.EOF {
+				return nil
+			}
+			return connectError(err, nil, nil)
+		}
+		if err := send(res); err != nil {
+			return err
+		}
+	}
+}
+
+// connectHeaders are the request headers of the Connect protocol, not passed on to the grpc calls
+var connectHeaders = map[string]bool{
+	"accept-encoding":  true,
+	"connection":       true,
+	"content-encoding": true,
+	"content-length":   true,
+	"content-type":     true,
+	"host":             true,
+	"te":               true,
+	"x-forwarded-for":  true,
+}
+
+// outgoingContext returns ctx with the headers of a Connect request as metadata of the grpc call,
+// and the client appended to x-forwarded-for to be the peer of the call
+func outgoingContext(ctx context.Context, header http.Header, p connect.Peer) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if connectHeaders[key] || strings.HasPrefix(key, "connect-") || strings.HasPrefix(key, "grpc-") {
+			continue
+		}
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				b, err := connect.DecodeBinaryHeader(value)
+				if err != nil {
+					continue
+				}
+				value = string(b)
+			}
+			md.Append(key, value)
+		}
+	}
+	hops := header.Values("X-Forwarded-For")
+	if host, _, err := net.SplitHostPort(p.Addr); err == nil {
+		hops = append(hops, host)
+	}
+	if len(hops) > 0 {
+		md.Set("x-forwarded-for", strings.Join(hops, ", "))
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// copyMetadata adds the metadata of a grpc call to the headers or trailers of a Connect response
+func copyMetadata(dst http.Header, md metadata.MD) {
+	for key, values := range md {
+		if strings.HasPrefix(key, "grpc-") || key == "content-type" {
+			continue
+		}
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				value = connect.EncodeBinaryHeader([]byte(value))
+			}
+			dst.Add(key, value)
+		}
+	}
+}
+
+// connectError returns the Connect error with the code, message and details of the grpc error err
+// header and trailer, the metadata of the failed call, are sent with the error
+func connectError(err error, header, trailer metadata.MD) error {
+	st := status.Convert(err)
+	cerr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Proto().GetDetails() {
+		if d, err := connect.NewErrorDetail(detail); err == nil {
+			cerr.AddDetail(d)
+		}
+	}
+	copyMetadata(cerr.Meta(), header)
+	copyMetadata(cerr.Meta(), trailer)
+	return cerr
+}
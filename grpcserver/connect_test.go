@@ -0,0 +1,194 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/rahulsingh/go-grpc-examples/calculator/calculatorservice"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// startTestConnect serves native grpc and Connect calls of the greet and calculator services on a local port
+// as server.connect does, and returns the address of the port
+func startTestConnect(t *testing.T) string {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Server.Connect = true
+	cfg.Server.Services = []string{config.ServiceGreet, config.ServiceCalculator}
+	register := func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, greetservice.NewServer())
+		calculatorv1.RegisterCalculatorServiceServer(s, calculatorservice.NewServer())
+	}
+	server := startTestServer(t, &cfg, register)
+	unary, stream, err := interceptors(&cfg, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, newCallTracker())
+	if err != nil {
+		t.Fatalf("interceptors: %v", err)
+	}
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(unary...), grpc.ChainStreamInterceptor(stream...))
+	register(s)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := newConnectServer(&cfg, s, server.inproc)
+	go cs.serve(lis)
+	t.Cleanup(cs.Stop)
+	return lis.Addr().String()
+}
+
+// h2cClient is an HTTP/2 client without TLS, as Connect clients of streaming calls use
+var h2cClient = &http.Client{Transport: &http.Transport{
+	Protocols: func() *http.Protocols {
+		protocols := new(http.Protocols)
+		protocols.SetUnencryptedHTTP2(true)
+		return protocols
+	}(),
+}}
+
+func TestConnectUnary(t *testing.T) {
+	url := "http://" + startTestConnect(t) + greetv1.GreetService_Greet_FullMethodName
+	codecs := []struct {
+		name    string
+		options []connect.ClientOption
+	}{
+		{"binary", nil},
+		{"json", []connect.ClientOption{connect.WithProtoJSON()}},
+	}
+	for _, codec := range codecs {
+		t.Run(codec.name, func(t *testing.T) {
+			client := connect.NewClient[greetv1.GreetRequest, greetv1.GreetResponse](http.DefaultClient, url, codec.options...)
+			res, err := client.CallUnary(context.Background(), connect.NewRequest(&greetv1.GreetRequest{
+				Greeting: &greetv1.Greeting{FirstName: "Rahul", LastName: "Singh"},
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Msg.GetResult() != "Hello Rahul Singh" {
+				t.Errorf("Greet = %q, want Hello Rahul Singh", res.Msg.GetResult())
+			}
+		})
+	}
+}
+
+// TestConnectServerStream runs beside the other tests, GreetManyTimes pauses a second between responses
+func TestConnectServerStream(t *testing.T) {
+	t.Parallel()
+	url := "http://" + startTestConnect(t) + greetv1.GreetService_GreetManyTimes_FullMethodName
+	client := connect.NewClient[greetv1.GreetManyTimesRequest, greetv1.GreetManyTimesResponse](h2cClient, url)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	stream, err := client.CallServerStream(ctx, connect.NewRequest(&greetv1.GreetManyTimesRequest{
+		Greeting: &greetv1.Greeting{FirstName: "Rahul"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	var responses int
+	for stream.Receive() {
+		responses++
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if responses != 10 {
+		t.Errorf("GreetManyTimes: %d responses, want 10", responses)
+	}
+}
+
+func TestConnectClientStream(t *testing.T) {
+	url := "http://" + startTestConnect(t) + calculatorv1.CalculatorService_ComputeAverage_FullMethodName
+	client := connect.NewClient[calculatorv1.ComputeAverageRequest, calculatorv1.ComputeAverageResponse](h2cClient, url)
+	stream := client.CallClientStream(context.Background())
+	for _, number := range []int32{1, 2, 3, 4} {
+		if err := stream.Send(&calculatorv1.ComputeAverageRequest{Number: number}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err := stream.CloseAndReceive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Msg.GetAverage() != 2.5 {
+		t.Errorf("ComputeAverage = %v, want 2.5", res.Msg.GetAverage())
+	}
+}
+
+// TestConnectError checks that a Connect client gets the code, message and details a grpc client gets,
+// the grpc call is made on the same port
+func TestConnectError(t *testing.T) {
+	address := startTestConnect(t)
+	req := &calculatorv1.SquareRootRequest{Number: -4}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = calculatorv1.NewCalculatorServiceClient(conn).SquareRoot(context.Background(), req)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("grpc SquareRoot(-4): %v, want INVALID_ARGUMENT", err)
+	}
+
+	client := connect.NewClient[calculatorv1.SquareRootRequest, calculatorv1.SquareRootResponse](http.DefaultClient,
+		"http://"+address+calculatorv1.CalculatorService_SquareRoot_FullMethodName)
+	_, err = client.CallUnary(context.Background(), connect.NewRequest(req))
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) {
+		t.Fatalf("Connect SquareRoot(-4): %v, want a Connect error", err)
+	}
+	if cerr.Code() != connect.CodeInvalidArgument {
+		t.Errorf("Connect code %v, want %v", cerr.Code(), connect.CodeInvalidArgument)
+	}
+	if cerr.Message() != st.Message() {
+		t.Errorf("Connect message %q, want %q", cerr.Message(), st.Message())
+	}
+
+	details := st.Proto().GetDetails()
+	if len(cerr.Details()) != len(details) {
+		t.Fatalf("Connect error has %d details, want %d", len(cerr.Details()), len(details))
+	}
+	var errorInfo, badRequest bool
+	for i, detail := range cerr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := anypb.UnmarshalNew(details[i], proto.UnmarshalOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(value, want) {
+			t.Errorf("Connect detail %d = %v, want %v", i, value, want)
+		}
+		switch value.(type) {
+		case *errdetails.ErrorInfo:
+			errorInfo = true
+		case *errdetails.BadRequest:
+			badRequest = true
+		}
+	}
+	if !errorInfo || !badRequest {
+		t.Errorf("details %v, want ErrorInfo and BadRequest", details)
+	}
+}
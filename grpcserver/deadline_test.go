@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// stopped is how soon a handler must return after its call is cancelled or out of time,
+// well below the pauses of the handlers
+const stopped = 500 * time.Millisecond
+
+// TestClientCancelStopsHandler cancels the server streaming calls after their first response,
+// GreetManyTimes pauses a second between responses and factoring twice a large prime takes forever
+func TestClientCancelStopsHandler(t *testing.T) {
+	tests := []struct {
+		method string
+		// open starts the call on conn and returns the function receiving its responses
+		open func(ctx context.Context, conn *grpc.ClientConn) (func() error, error)
+	}{
+		{"GreetManyTimes", func(ctx context.Context, conn *grpc.ClientConn) (func() error, error) {
+			stream, err := greetv1.NewGreetServiceClient(conn).GreetManyTimes(ctx, &greetv1.GreetManyTimesRequest{
+				Greeting: &greetv1.Greeting{FirstName: "Rahul"},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return func() error { _, err := stream.Recv(); return err }, nil
+		}},
+		{"PrimeNumberDecomposition", func(ctx context.Context, conn *grpc.ClientConn) (func() error, error) {
+			// 2^62-57 is prime
+			stream, err := calculatorv1.NewCalculatorServiceClient(conn).PrimeNumberDecomposition(ctx,
+				&calculatorv1.PrimeNumberDecompositionRequest{InputNumber: 2 * (1<<62 - 57)})
+			if err != nil {
+				return nil, err
+			}
+			return func() error { _, err := stream.Recv(); return err }, nil
+		}},
+	}
+
+	server := startExampleServer(t)
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			recv, err := tt.open(ctx, server.conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := recv(); err != nil {
+				t.Fatalf("first response: %v", err)
+			}
+			cancel()
+			if err := recv(); status.Code(err) != codes.Canceled {
+				t.Errorf("cancelled call: %v, want CANCELED", err)
+			}
+			server.waitIdle(t, stopped)
+		})
+	}
+}
+
+// TestDeadlineRules checks that a default= rule gives a deadline to calls without one
+// and that a max= rule shortens the deadline of the client
+func TestDeadlineRules(t *testing.T) {
+	cfg := config.Default()
+	cfg.Server.Deadlines.Rules = []string{
+		"/greet.v1.GreetService/GreetWithDeadLine default=100ms max=200ms",
+	}
+	server := startTestServer(t, &cfg, func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, greetservice.NewServer())
+	})
+	client := greetv1.NewGreetServiceClient(server.conn)
+
+	// GreetWithDeadLine answers after 3 seconds
+	tests := []struct {
+		name    string
+		timeout time.Duration // deadline of the client, none if 0
+		want    time.Duration // deadline the server enforces
+	}{
+		{"default", 0, 100 * time.Millisecond},
+		{"max", time.Minute, 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			start := time.Now()
+			_, err := client.GreetWithDeadLine(ctx, &greetv1.GreetWithDeadLineRequest{})
+			elapsed := time.Since(start)
+			if status.Code(err) != codes.DeadlineExceeded {
+				t.Fatalf("GreetWithDeadLine: %v, want DEADLINE_EXCEEDED", err)
+			}
+			if elapsed < tt.want || elapsed > tt.want+stopped {
+				t.Errorf("GreetWithDeadLine failed after %v, want %v", elapsed, tt.want)
+			}
+			server.waitIdle(t, stopped)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/rs/cors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+	"github.com/rahulsingh/go-grpc-examples/openapi"
+)
+
+// forwardedHeaders are the HTTP headers passed on to the grpc calls of the gateway, besides Authorization
+// and the Grpc-Metadata- prefixed ones the gateway always forwards
+var forwardedHeaders = map[string]bool{
+	logging.RequestIDHeader: true,
+	"traceparent":           true,
+	"tracestate":            true,
+}
+
+// gateway serves the REST/JSON endpoints declared by the google.api.http options of the protos on server.http.address
+// as calls to the in-process grpc server; server streams are sent as newline-delimited JSON.
+// The OpenAPI document of the endpoints is served on openapi.Path.
+// With server.http.grpc_web, browsers can also call all methods of the services with gRPC-Web on the same listener,
+// server streams included; cross-origin calls are allowed from server.http.cors_origins
+type gateway struct {
+	http *http.Server
+}
+
+// newGateway starts the HTTP server in front of inproc
+func newGateway(cfg *config.Config, inproc *inProcess) (*gateway, error) {
+	lis, err := net.Listen("tcp", cfg.Server.HTTP.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to listen tcp for HTTP: %v", err)
+	}
+	if cfg.Server.TLS.Enabled {
+		tlsCfg, err := mtls.HTTPServerConfig(tlsOptions(cfg))
+		if err != nil {
+			lis.Close()
+			return nil, err
+		}
+		lis = tls.NewListener(lis, tlsCfg)
+	}
+
+	handler, err := newGatewayHandler(cfg, inproc)
+	if err != nil {
+		lis.Close()
+		return nil, err
+	}
+	gw := &gateway{http: &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}}
+	go func() {
+		if err := gw.http.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("HTTP server failed", "error", err)
+		}
+	}()
+	return gw, nil
+}
+
+// newGatewayHandler returns the handler of the REST endpoints, the OpenAPI document and gRPC-Web calls of inproc
+func newGatewayHandler(cfg *config.Config, inproc *inProcess) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(header string) (string, bool) {
+			if forwardedHeaders[strings.ToLower(header)] {
+				return strings.ToLower(header), true
+			}
+			return runtime.DefaultHeaderMatcher(header)
+		}),
+		// JSON with the proto field names and without the fields at their default value, as documented in openapi
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{Marshaler: &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}}),
+	)
+	ctx := context.Background()
+	var err error
+	if cfg.Server.Enabled(config.ServiceBlog) {
+		err = blogv1.RegisterBlogServiceHandler(ctx, mux, inproc.conn)
+		if err == nil {
+			err = blogv2.RegisterBlogServiceHandler(ctx, mux, inproc.conn)
+		}
+	}
+	if err == nil && cfg.Server.Enabled(config.ServiceCalculator) {
+		err = calculatorv1.RegisterCalculatorServiceHandler(ctx, mux, inproc.conn)
+	}
+	if err == nil && cfg.Server.Enabled(config.ServiceGreet) {
+		err = greetv1.RegisterGreetServiceHandler(ctx, mux, inproc.conn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rest := http.NewServeMux()
+	rest.Handle(openapi.Path, openapi.Handler())
+	rest.Handle("/", inproc.forHTTP(mux))
+	// credentials are only allowed from the listed origins, any origin gets the public endpoints and explicit headers
+	handler := cors.New(cors.Options{
+		AllowOriginFunc:  allowOrigin(cfg.Server.HTTP.CORSOrigins),
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{logging.RequestIDHeader},
+		AllowCredentials: !anyOrigin(cfg.Server.HTTP.CORSOrigins),
+	}).Handler(rest)
+	if cfg.Server.HTTP.GRPCWeb {
+		handler = grpcWebHandler(grpcweb.WrapServer(inproc.grpc, grpcweb.WithOriginFunc(allowOrigin(cfg.Server.HTTP.CORSOrigins))), handler)
+	}
+	return handler, nil
+}
+
+// stopAccepting closes the HTTP listener and idle connections, requests in flight go on
+func (gw *gateway) stopAccepting() {
+	gw.http.SetKeepAlivesEnabled(false)
+	go gw.http.Shutdown(context.Background())
+}
+
+// close stops the HTTP server, cancelling the requests in flight
+func (gw *gateway) close() {
+	gw.http.Close()
+}
+
+// grpcWebHandler sends gRPC-Web calls and their CORS preflight requests to web, and the other requests to next
+func grpcWebHandler(web *grpcweb.WrappedGrpcServer, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if web.IsGrpcWebRequest(r) || web.IsAcceptableGrpcCorsRequest(r) {
+			web.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// anyOrigin returns whether origins allow every origin with *
+func anyOrigin(origins []string) bool {
+	for _, allowed := range origins {
+		if allowed == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// allowOrigin returns whether browser apps served from origin may call the HTTP server
+func allowOrigin(origins []string) func(origin string) bool {
+	return func(origin string) bool {
+		for _, allowed := range origins {
+			if allowed == "*" || strings.EqualFold(allowed, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
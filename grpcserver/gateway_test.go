@@ -0,0 +1,340 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/calculator/calculatorservice"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+)
+
+// startTestGateway serves the greet and calculator services over HTTP as the gateway of cfg does
+func startTestGateway(t *testing.T, cfg *config.Config) *httptest.Server {
+	t.Helper()
+	cfg.Server.Services = []string{config.ServiceGreet, config.ServiceCalculator}
+	gw := httptest.NewServer(newTestGatewayHandler(t, cfg, func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, greetservice.NewServer())
+		calculatorv1.RegisterCalculatorServiceServer(s, calculatorservice.NewServer())
+	}))
+	t.Cleanup(gw.Close)
+	return gw
+}
+
+// newTestGatewayHandler returns the gateway handler of cfg in front of the services added by register
+func newTestGatewayHandler(t *testing.T, cfg *config.Config, register func(*grpc.Server)) http.Handler {
+	t.Helper()
+	server := startTestServer(t, cfg, register)
+	handler, err := newGatewayHandler(cfg, server.inproc)
+	if err != nil {
+		t.Fatalf("newGatewayHandler: %v", err)
+	}
+	return handler
+}
+
+// grpcWebCall posts req to method as a gRPC-Web call and returns the messages and the trailers of the response
+func grpcWebCall(t *testing.T, url, method string, req proto.Message) ([][]byte, http.Header) {
+	t.Helper()
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body bytes.Buffer
+	body.WriteByte(0)
+	binary.Write(&body, binary.BigEndian, uint32(len(data)))
+	body.Write(data)
+
+	httpReq, err := http.NewRequest(http.MethodPost, url+method, &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/grpc-web+proto")
+	httpReq.Header.Set("X-Grpc-Web", "1")
+	res, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("POST %v: %v", method, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("POST %v: HTTP status %v", method, res.Status)
+	}
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/grpc-web+proto") {
+		t.Fatalf("POST %v: Content-Type %q", method, ct)
+	}
+
+	// frames are a flags byte, the big-endian length and the payload; flag 0x80 marks the trailers
+	var messages [][]byte
+	for {
+		var header [5]byte
+		_, err := io.ReadFull(res.Body, header[:])
+		if err == io.EOF && len(messages) == 0 {
+			// a trailers-only response, e.g. of an error, has its status in the HTTP headers
+			return nil, res.Header
+		}
+		if err != nil {
+			t.Fatalf("POST %v: response without trailer frame: %v", method, err)
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		if _, err := io.ReadFull(res.Body, payload); err != nil {
+			t.Fatalf("POST %v: truncated frame: %v", method, err)
+		}
+		if header[0]&0x80 == 0 {
+			messages = append(messages, payload)
+			continue
+		}
+		trailers := http.Header{}
+		for _, line := range strings.Split(strings.TrimSpace(string(payload)), "\r\n") {
+			key, value, _ := strings.Cut(line, ":")
+			trailers.Add(strings.TrimSpace(key), strings.TrimSpace(value))
+		}
+		// the trailer frame ends the response
+		if rest, _ := io.ReadAll(res.Body); len(rest) > 0 {
+			t.Fatalf("POST %v: %d bytes after the trailer frame", method, len(rest))
+		}
+		return messages, trailers
+	}
+}
+
+func TestGRPCWeb(t *testing.T) {
+	cfg := config.Default()
+	cfg.Server.HTTP.GRPCWeb = true
+	gw := startTestGateway(t, &cfg)
+
+	messages, trailers := grpcWebCall(t, gw.URL, "/greet.v1.GreetService/Greet",
+		&greetv1.GreetRequest{Greeting: &greetv1.Greeting{FirstName: "Rahul", LastName: "Singh"}})
+	if status := trailers.Get("grpc-status"); status != "0" {
+		t.Fatalf("Greet: grpc-status %q, want 0 (trailers %v)", status, trailers)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("Greet: %d messages, want 1", len(messages))
+	}
+	res := &greetv1.GreetResponse{}
+	if err := proto.Unmarshal(messages[0], res); err != nil {
+		t.Fatal(err)
+	}
+	if res.GetResult() != "Hello Rahul Singh" {
+		t.Errorf("Greet = %q, want Hello Rahul Singh", res.GetResult())
+	}
+
+	messages, trailers = grpcWebCall(t, gw.URL, "/calculator.v1.CalculatorService/Sum",
+		&calculatorv1.SumRequest{FirstNumber: 3, SecondNumber: 4})
+	if status := trailers.Get("grpc-status"); status != "0" || len(messages) != 1 {
+		t.Fatalf("Sum: %d messages and grpc-status %q, want 1 and 0 (trailers %v)", len(messages), status, trailers)
+	}
+	sum := &calculatorv1.SumResponse{}
+	if err := proto.Unmarshal(messages[0], sum); err != nil {
+		t.Fatal(err)
+	}
+	if sum.GetSumResult() != 7 {
+		t.Errorf("Sum(3, 4) = %d, want 7", sum.GetSumResult())
+	}
+
+	// a failed call has no messages, its status comes as trailers-only response
+	_, trailers = grpcWebCall(t, gw.URL, "/calculator.v1.CalculatorService/SquareRoot", &calculatorv1.SquareRootRequest{Number: -4})
+	if status := trailers.Get("grpc-status"); status != "3" {
+		t.Errorf("SquareRoot(-4): grpc-status %q, want 3 INVALID_ARGUMENT (trailers %v)", status, trailers)
+	}
+}
+
+// TestGRPCWebServerStream runs beside the other tests, GreetManyTimes pauses a second between responses
+func TestGRPCWebServerStream(t *testing.T) {
+	t.Parallel()
+	cfg := config.Default()
+	cfg.Server.HTTP.GRPCWeb = true
+	gw := startTestGateway(t, &cfg)
+
+	// grpcWebCall fails unless the data frames are followed by the trailer frame, which ends the response
+	messages, trailers := grpcWebCall(t, gw.URL, "/greet.v1.GreetService/GreetManyTimes",
+		&greetv1.GreetManyTimesRequest{Greeting: &greetv1.Greeting{FirstName: "Rahul"}})
+	if len(messages) != 10 {
+		t.Errorf("GreetManyTimes: %d data frames, want 10", len(messages))
+	}
+	if status := trailers.Get("grpc-status"); status != "0" {
+		t.Errorf("GreetManyTimes: grpc-status %q in the trailer frame, want 0 (trailers %v)", status, trailers)
+	}
+	for i, message := range messages {
+		res := &greetv1.GreetManyTimesResponse{}
+		if err := proto.Unmarshal(message, res); err != nil {
+			t.Fatalf("frame %d: %v", i, err)
+		}
+		if !strings.Contains(res.GetResult(), "Rahul") {
+			t.Errorf("frame %d = %q, want a greeting of Rahul", i, res.GetResult())
+		}
+	}
+}
+
+// echoBlogV1 and echoBlogV2 are BlogServices whose UpdateBlog returns the blog of the request
+type echoBlogV1 struct {
+	blogv1.UnimplementedBlogServiceServer
+}
+
+func (echoBlogV1) UpdateBlog(_ context.Context, req *blogv1.UpdateBlogRequest) (*blogv1.UpdateBlogResponse, error) {
+	return &blogv1.UpdateBlogResponse{Blog: req.GetBlog()}, nil
+}
+
+type echoBlogV2 struct {
+	blogv2.UnimplementedBlogServiceServer
+}
+
+func (echoBlogV2) UpdateBlog(_ context.Context, req *blogv2.UpdateBlogRequest) (*blogv2.UpdateBlogResponse, error) {
+	return &blogv2.UpdateBlogResponse{Blog: req.GetBlog()}, nil
+}
+
+// TestGatewayUpdateBlog checks that UpdateBlog of both versions is served as PUT and PATCH, allowed by CORS
+func TestGatewayUpdateBlog(t *testing.T) {
+	cfg := config.Default()
+	cfg.Server.Services = []string{config.ServiceBlog}
+	cfg.Server.HTTP.CORSOrigins = []string{"https://app.example.com"}
+	gw := httptest.NewServer(newTestGatewayHandler(t, &cfg, func(s *grpc.Server) {
+		blogv1.RegisterBlogServiceServer(s, echoBlogV1{})
+		blogv2.RegisterBlogServiceServer(s, echoBlogV2{})
+	}))
+	defer gw.Close()
+
+	for _, version := range []string{"v1", "v2"} {
+		for _, method := range []string{http.MethodPut, http.MethodPatch} {
+			t.Run(version+" "+method, func(t *testing.T) {
+				url := gw.URL + "/" + version + "/blogs/0123456789abcdef01234567"
+				req, err := http.NewRequest(method, url, strings.NewReader(`{"title": "Hello"}`))
+				if err != nil {
+					t.Fatal(err)
+				}
+				res, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, _ := io.ReadAll(res.Body)
+				res.Body.Close()
+				if res.StatusCode != http.StatusOK {
+					t.Fatalf("%v %v: HTTP status %v: %s", method, url, res.Status, body)
+				}
+				if !strings.Contains(string(body), `"id":"0123456789abcdef01234567"`) || !strings.Contains(string(body), `"title":"Hello"`) {
+					t.Errorf("%v %v = %s, want the blog with the id of the path", method, url, body)
+				}
+
+				preflight, err := http.NewRequest(http.MethodOptions, url, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				preflight.Header.Set("Origin", "https://app.example.com")
+				preflight.Header.Set("Access-Control-Request-Method", method)
+				res, err = http.DefaultClient.Do(preflight)
+				if err != nil {
+					t.Fatal(err)
+				}
+				res.Body.Close()
+				if allowed := res.Header.Get("Access-Control-Allow-Methods"); !strings.Contains(allowed, method) {
+					t.Errorf("CORS preflight of %v allows %q", method, allowed)
+				}
+			})
+		}
+	}
+}
+
+func TestCORSCredentials(t *testing.T) {
+	tests := []struct {
+		origins     []string
+		credentials bool
+	}{
+		{[]string{"https://app.example.com"}, true},
+		{[]string{"*"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.origins, ","), func(t *testing.T) {
+			cfg := config.Default()
+			cfg.Server.HTTP.CORSOrigins = tt.origins
+			gw := startTestGateway(t, &cfg)
+
+			req, err := http.NewRequest(http.MethodGet, gw.URL+"/v1/calculator/sum?first_number=3&second_number=4", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Origin", "https://app.example.com")
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if origin := res.Header.Get("Access-Control-Allow-Origin"); origin == "" {
+				t.Errorf("origin not allowed")
+			}
+			if got := res.Header.Get("Access-Control-Allow-Credentials") == "true"; got != tt.credentials {
+				t.Errorf("credentials allowed = %v, want %v", got, tt.credentials)
+			}
+		})
+	}
+}
+
+// callerGreet is the GreetService greeting the caller identified by its client certificate from its address
+type callerGreet struct {
+	*greetservice.Server
+}
+
+func (callerGreet) Greet(ctx context.Context, _ *greetv1.GreetRequest) (*greetv1.GreetResponse, error) {
+	name := "anonymous"
+	if id, ok := mtls.IdentityFromContext(ctx); ok {
+		name = id.Name()
+	}
+	p, _ := peer.FromContext(ctx)
+	return &greetv1.GreetResponse{Result: fmt.Sprintf("Hello %v from %v", name, p.Addr)}, nil
+}
+
+// TestGatewayCallerIdentity checks that calls of the gateway have the HTTP client as peer,
+// with the client certificate of the HTTPS request
+func TestGatewayCallerIdentity(t *testing.T) {
+	cfg := config.Default()
+	cfg.Server.Services = []string{config.ServiceGreet}
+	handler := newTestGatewayHandler(t, &cfg, func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, callerGreet{greetservice.NewServer()})
+	})
+
+	// the TLS state of a request whose client certificate was verified by the HTTP server
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "client"}, DNSNames: []string{"app.example.com"}}
+	tests := []struct {
+		name string
+		tls  *tls.ConnectionState
+		want string
+	}{
+		{"https", &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}, "Hello app.example.com from 192.0.2.1:0"},
+		{"http", nil, "Hello anonymous from 192.0.2.1:0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/greet", strings.NewReader(`{"first_name": "Rahul"}`))
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.TLS = tt.tls
+			// a client cannot claim the TLS state of another request
+			req.Header.Set("Grpc-Metadata-"+requestIDKey, "guessed")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			res := &greetv1.GreetResponse{}
+			if err := protojson.Unmarshal(rec.Body.Bytes(), res); err != nil {
+				t.Fatalf("response %v %q: %v", rec.Code, rec.Body, err)
+			}
+			if res.GetResult() != tt.want {
+				t.Errorf("Greet = %q, want %q", res.GetResult(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/rahulsingh/go-grpc-examples/config"
+)
+
+// healthServices maps the services of server.services to the names they report their health under,
+// their full grpc service names as clients and load balancers know them, legacy names included
+var healthServices = map[string][]string{
+	config.ServiceGreet:      {"greet.v1.GreetService", "greet.GreetService"},
+	config.ServiceCalculator: {"calculator.v1.CalculatorService", "calculator.CalculatorService"},
+	config.ServiceBlog:       {"blog.v1.BlogService", "blog.v2.BlogService", "blog.BlogService"},
+}
+
+// newHealthServer returns the grpc.health.v1 service of the enabled services
+// greet and calculator have no dependencies and serve right away,
+// the blog service is NOT_SERVING until watchStore reached its store
+// the empty service name reports the health of the server as a whole, SERVING until shutdown
+func newHealthServer(cfg *config.Config) *health.Server {
+	hs := health.NewServer()
+	for _, name := range cfg.Server.Services {
+		status := healthpb.HealthCheckResponse_SERVING
+		if name == config.ServiceBlog {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range healthServices[name] {
+			hs.SetServingStatus(service, status)
+		}
+	}
+	return hs
+}
+
+// watchStore pings the mongodb of the blog service every interval until ctx is done,
+// reporting the blog service NOT_SERVING while the store does not answer
+func watchStore(ctx context.Context, hs *health.Server, client *mongo.Client, interval time.Duration, logger *slog.Logger) {
+	// assumed reachable, so a store down at startup is logged
+	reachable := true
+	check := func() {
+		pingCtx, cancel := context.WithTimeout(ctx, interval)
+		err := client.Ping(pingCtx, readpref.Primary())
+		cancel()
+		if ctx.Err() != nil {
+			// shutting down, the health server already reports NOT_SERVING
+			return
+		}
+
+		switch {
+		case err != nil && reachable:
+			logger.Warn("blog store is unreachable, blog service is not serving", "error", err)
+		case err == nil && !reachable:
+			logger.Info("blog store is reachable, blog service is serving")
+		}
+		reachable = err == nil
+		status := healthpb.HealthCheckResponse_SERVING
+		if !reachable {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range healthServices[config.ServiceBlog] {
+			hs.SetServingStatus(service, status)
+		}
+	}
+
+	check()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			check()
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// callTracker keeps the calls in flight, so a shutdown can report the ones it waits for or cancels
+type callTracker struct {
+	mu    sync.Mutex
+	next  uint64
+	calls map[uint64]inflightCall
+}
+
+// inflightCall is a call being handled
+type inflightCall struct {
+	method string
+	peer   string
+	start  time.Time
+}
+
+func newCallTracker() *callTracker {
+	return &callTracker{calls: map[uint64]inflightCall{}}
+}
+
+// begin records a call and returns the function removing it once handled
+func (t *callTracker) begin(ctx context.Context, method string) func() {
+	call := inflightCall{method: method, start: time.Now()}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		call.peer = p.Addr.String()
+	}
+
+	t.mu.Lock()
+	id := t.next
+	t.next++
+	t.calls[id] = call
+	t.mu.Unlock()
+
+	return func() {
+		t.mu.Lock()
+		delete(t.calls, id)
+		t.mu.Unlock()
+	}
+}
+
+// count returns the number of calls in flight
+func (t *callTracker) count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.calls)
+}
+
+// log writes one line per call in flight, oldest first
+func (t *callTracker) log(logger *slog.Logger, msg string) {
+	t.mu.Lock()
+	calls := make([]inflightCall, 0, len(t.calls))
+	for _, call := range t.calls {
+		calls = append(calls, call)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(calls, func(i, j int) bool { return calls[i].start.Before(calls[j].start) })
+	for _, call := range calls {
+		logger.Warn(msg, "method", call.method, "peer", call.peer, "running_ms", time.Since(call.start).Milliseconds())
+	}
+}
+
+// UnaryServerInterceptor tracks unary calls
+func (t *callTracker) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		defer t.begin(ctx, info.FullMethod)()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor tracks streaming calls
+func (t *callTracker) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		defer t.begin(ss.Context(), info.FullMethod)()
+		return handler(srv, ss)
+	}
+}
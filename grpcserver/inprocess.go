@@ -0,0 +1,155 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// inProcessBufferSize is the size of the in-memory connection to the in-process grpc server
+const inProcessBufferSize = 1 << 20
+
+// inProcess is a grpc server only reachable from this process, hosting the same services with the same interceptors
+// as the grpc listener. The REST gateway and the Connect handlers turn HTTP requests into calls to it, and gRPC-Web
+// requests are served by it, so authentication, logging, metrics and limits apply to them as to grpc calls
+type inProcess struct {
+	grpc *grpc.Server
+	conn *grpc.ClientConn // client of grpc
+
+	// TLS states of the HTTP requests served by handlers wrapped with forHTTP, by request id
+	requests sync.Map
+}
+
+// requestIDKey is the metadata key of the calls to the in-process server naming the HTTP request they are made for
+const requestIDKey = "x-inprocess-request"
+
+// requestIDContextKey is the context key of the id of the HTTP request registered by forHTTP
+type requestIDContextKey struct{}
+
+// newInProcess starts the in-process grpc server with the services added by register
+func newInProcess(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor, register func(*grpc.Server)) (*inProcess, error) {
+	// the peer of the calls is the HTTP client, not the in-memory connection
+	lis := bufconn.Listen(inProcessBufferSize)
+	p := &inProcess{}
+	p.grpc = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{p.forwardedPeerUnary}, unary...)...),
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{p.forwardedPeerStream}, stream...)...),
+	)
+	register(p.grpc)
+	go p.grpc.Serve(lis)
+
+	var err error
+	p.conn, err = grpc.NewClient("passthrough:///inprocess",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(requestIDUnary),
+		grpc.WithChainStreamInterceptor(requestIDStream),
+	)
+	if err != nil {
+		p.grpc.Stop()
+		return nil, err
+	}
+	return p, nil
+}
+
+// forHTTP registers the TLS state of the requests served by next, so that the calls next makes to the in-process
+// server have the TLS state of their HTTP request, and the client certificate identifies the caller
+func (p *inProcess) forHTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// the id is random so that a client cannot send the id of another request
+		b := make([]byte, 16)
+		rand.Read(b)
+		id := hex.EncodeToString(b)
+		p.requests.Store(id, r.TLS)
+		defer p.requests.Delete(id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDContextKey{}, id)))
+	})
+}
+
+// withRequestID returns ctx with the id of the HTTP request of ctx in the outgoing metadata, replacing any sent by the client
+func withRequestID(ctx context.Context) context.Context {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	if !ok {
+		return ctx
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(requestIDKey, id)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func requestIDUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withRequestID(ctx), method, req, reply, cc, opts...)
+}
+
+func requestIDStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withRequestID(ctx), desc, cc, method, opts...)
+}
+
+// close cancels the calls in flight and stops the server
+func (p *inProcess) close() {
+	p.conn.Close()
+	p.grpc.Stop()
+}
+
+// forwardedPeer returns ctx with the HTTP client as peer: its address is the one the gateway and the Connect handlers
+// append last to x-forwarded-for, its TLS state the one of the HTTP request registered by forHTTP.
+// gRPC-Web calls are served by the in-process server directly, they already have the HTTP client as peer
+// and their x-forwarded-for comes from the client
+func (p *inProcess) forwardedPeer(ctx context.Context) context.Context {
+	conn, ok := peer.FromContext(ctx)
+	if !ok || conn.Addr == nil || conn.Addr.Network() != "bufconn" {
+		return ctx
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := *conn
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		hops := strings.Split(values[len(values)-1], ",")
+		if ip := net.ParseIP(strings.TrimSpace(hops[len(hops)-1])); ip != nil {
+			forwarded.Addr = &net.TCPAddr{IP: ip}
+		}
+	}
+	for _, id := range md.Get(requestIDKey) {
+		if state, ok := p.requests.Load(id); ok {
+			forwarded.AuthInfo = credentials.TLSInfo{
+				State:          *state.(*tls.ConnectionState),
+				CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+			}
+		}
+	}
+	return peer.NewContext(ctx, &forwarded)
+}
+
+func (p *inProcess) forwardedPeerUnary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(p.forwardedPeer(ctx), req)
+}
+
+func (p *inProcess) forwardedPeerStream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: p.forwardedPeer(ss.Context())})
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
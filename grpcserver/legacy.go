@@ -0,0 +1,106 @@
+package grpcserver
+
+import (
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	v1reflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	v1alphareflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// legacyServices maps the services of the protos before they were versioned to their v1 service
+// clients built before versioning keep calling the old names, served by the v1 implementations:
+// the messages of both are the same on the wire
+var legacyServices = map[string]string{
+	"greet.GreetService":           "greet.v1.GreetService",
+	"calculator.CalculatorService": "calculator.v1.CalculatorService",
+	"blog.BlogService":             "blog.v1.BlogService",
+}
+
+// registerWithLegacyName registers impl with s as the service of desc, and as its legacy service if it has one
+func registerWithLegacyName(s *grpc.Server, desc *grpc.ServiceDesc, impl interface{}) {
+	s.RegisterService(desc, impl)
+	for legacy, v1 := range legacyServices {
+		if v1 == desc.ServiceName {
+			alias := *desc
+			alias.ServiceName = legacy
+			s.RegisterService(&alias, impl)
+		}
+	}
+}
+
+// legacyMethod returns the v1 name of fullMethod, e.g. /blog.v1.BlogService/ReadBlog for /blog.BlogService/ReadBlog,
+// and whether fullMethod is the method of a legacy service. The method may be * like in policy keys
+func legacyMethod(fullMethod string) (string, bool) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return fullMethod, false
+	}
+	v1, ok := legacyServices[service]
+	if !ok {
+		return fullMethod, false
+	}
+	return "/" + v1 + "/" + method, true
+}
+
+// legacyAlias returns the legacy name of the v1 method fullMethod, if its service had one
+func legacyAlias(fullMethod string) (string, bool) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "", false
+	}
+	for legacy, v1 := range legacyServices {
+		if v1 == service {
+			return "/" + legacy + "/" + method, true
+		}
+	}
+	return "", false
+}
+
+// legacyNameStream comes first among the stream interceptors, so that the others see stream calls of legacy
+// services as calls of their v1 method: info is shared by all chained interceptors.
+// Unary calls need no such interceptor, the generated handlers pass the v1 name to the interceptors
+func legacyNameStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if v1, ok := legacyMethod(info.FullMethod); ok {
+		info.FullMethod = v1
+	}
+	return handler(srv, ss)
+}
+
+// withV1Keys returns policy with the keys of legacy services renamed to their v1 service,
+// so that rules written before versioning still apply; a rule of the v1 name wins over one of the legacy name
+func withV1Keys[P ~map[string]R, R any](policy P) P {
+	renamed := make(P, len(policy))
+	for key, rule := range policy {
+		if v1, ok := legacyMethod(key); ok {
+			if _, set := policy[v1]; set {
+				continue
+			}
+			key = v1
+		}
+		renamed[key] = rule
+	}
+	return renamed
+}
+
+// registerReflection registers both versions of server reflection with s
+// the legacy services are not listed, there are no descriptors of their names
+func registerReflection(s *grpc.Server) {
+	opts := reflection.ServerOptions{Services: reflectedServices{s}}
+	v1reflectiongrpc.RegisterServerReflectionServer(s, reflection.NewServerV1(opts))
+	v1alphareflectiongrpc.RegisterServerReflectionServer(s, reflection.NewServer(opts))
+}
+
+// reflectedServices lists the services of a grpc server but the legacy ones
+type reflectedServices struct {
+	s *grpc.Server
+}
+
+func (r reflectedServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	info := r.s.GetServiceInfo()
+	for legacy := range legacyServices {
+		delete(info, legacy)
+	}
+	return info
+}
@@ -0,0 +1,44 @@
+package grpcserver
+
+import "github.com/rahulsingh/go-grpc-examples/auth"
+
+// Scopes and roles granted in bearer tokens, see the token command
+const (
+	scopeBlogRead  = "blog.read"
+	scopeBlogWrite = "blog.write"
+	roleAdmin      = "admin"
+)
+
+// methodPolicy declares who may call each method when server.auth is enabled
+// methods not listed here need an authenticated caller
+var methodPolicy = auth.Policy{
+	// tools like grpcurl list the services before any call
+	"/grpc.reflection.v1alpha.ServerReflection/*": {Public: true},
+	"/grpc.reflection.v1.ServerReflection/*":      {Public: true},
+
+	// load balancers and orchestrators check health without credentials
+	"/grpc.health.v1.Health/*": {Public: true},
+
+	// greet and calculator only need a valid token
+	// calls of the legacy services are checked as calls of their v1 service, see legacyServices
+	"/greet.v1.GreetService/*":           {},
+	"/calculator.v1.CalculatorService/*": {},
+
+	"/blog.v1.BlogService/ReadBlog":         {Scopes: []string{scopeBlogRead}},
+	"/blog.v1.BlogService/ListBlog":         {Scopes: []string{scopeBlogRead}},
+	"/blog.v1.BlogService/ListPopularBlogs": {Scopes: []string{scopeBlogRead}},
+	"/blog.v1.BlogService/GetRelatedBlogs":  {Scopes: []string{scopeBlogRead}},
+	"/blog.v1.BlogService/CreateBlog":       {Scopes: []string{scopeBlogWrite}},
+	"/blog.v1.BlogService/UpdateBlog":       {Scopes: []string{scopeBlogWrite}},
+	"/blog.v1.BlogService/DeleteBlog":       {Scopes: []string{scopeBlogWrite}, Roles: []string{roleAdmin}},
+
+	// states are not access rules, blog.v2 readers see drafts and archived blogs too
+	"/blog.v2.BlogService/GetBlog":          {Scopes: []string{scopeBlogRead}},
+	"/blog.v2.BlogService/GetBlogBySlug":    {Scopes: []string{scopeBlogRead}},
+	"/blog.v2.BlogService/ListBlogs":        {Scopes: []string{scopeBlogRead}},
+	"/blog.v2.BlogService/ListPopularBlogs": {Scopes: []string{scopeBlogRead}},
+	"/blog.v2.BlogService/GetRelatedBlogs":  {Scopes: []string{scopeBlogRead}},
+	"/blog.v2.BlogService/CreateBlog":       {Scopes: []string{scopeBlogWrite}},
+	"/blog.v2.BlogService/UpdateBlog":       {Scopes: []string{scopeBlogWrite}},
+	"/blog.v2.BlogService/DeleteBlog":       {Scopes: []string{scopeBlogWrite}, Roles: []string{roleAdmin}},
+}
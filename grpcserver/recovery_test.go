@@ -0,0 +1,55 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// panickingGreet is the GreetService with handlers of GreetWithDeadLine and GreetManyTimes that panic
+type panickingGreet struct {
+	*greetservice.Server
+}
+
+func (panickingGreet) GreetWithDeadLine(context.Context, *greetv1.GreetWithDeadLineRequest) (*greetv1.GreetWithDeadLineResponse, error) {
+	panic("unary handler bug")
+}
+
+func (panickingGreet) GreetManyTimes(*greetv1.GreetManyTimesRequest, greetv1.GreetService_GreetManyTimesServer) error {
+	var m map[string]int
+	m["stream handler bug"]++
+	return nil
+}
+
+func TestPanicFailsOnlyItsCall(t *testing.T) {
+	cfg := config.Default()
+	server := startTestServer(t, &cfg, func(s *grpc.Server) {
+		greetv1.RegisterGreetServiceServer(s, panickingGreet{greetservice.NewServer()})
+	})
+	client := greetv1.NewGreetServiceClient(server.conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.GreetWithDeadLine(ctx, &greetv1.GreetWithDeadLineRequest{})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("unary call with panicking handler: %v, want INTERNAL", err)
+	}
+	server.checkServing(t)
+
+	stream, err := client.GreetManyTimes(ctx, &greetv1.GreetManyTimesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Errorf("stream call with panicking handler: %v, want INTERNAL", err)
+	}
+	server.checkServing(t)
+}
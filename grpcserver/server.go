@@ -0,0 +1,468 @@
+// Package grpcserver hosts any combination of GreetService, CalculatorService and the two versions of BlogService
+// on one grpc.Server, sharing interceptors, TLS and shutdown handling
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+	mongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/rahulsingh/go-grpc-examples/auth"
+	"github.com/rahulsingh/go-grpc-examples/blog/blogservice"
+	blogv1 "github.com/rahulsingh/go-grpc-examples/blog/v1"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/calculator/calculatorservice"
+	calculatorv1 "github.com/rahulsingh/go-grpc-examples/calculator/v1"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/deadline"
+	"github.com/rahulsingh/go-grpc-examples/greet/greetservice"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/metrics"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+	"github.com/rahulsingh/go-grpc-examples/ratelimit"
+	"github.com/rahulsingh/go-grpc-examples/recovery"
+	"github.com/rahulsingh/go-grpc-examples/tracing"
+)
+
+// Run serves the enabled services until SIGINT (control+C) or SIGTERM, then shuts everything down:
+// health checks fail first, calls are accepted for server.shutdown_delay more, in-flight calls then get
+// server.shutdown_timeout to finish, then the stores are closed
+// cfg must have been validated
+func Run(cfg *config.Config) error {
+	logger, err := logging.Setup(logOptions(cfg))
+	if err != nil {
+		return err
+	}
+	shutdownTracing, err := tracing.Setup(tracingOptions(cfg))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// export the spans still buffered, the server is stopped by now
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("error while flushing spans", "error", err)
+		}
+	}()
+
+	// metrics of the grpc calls and of the blog store, nil when disabled
+	var m *metrics.Metrics
+	var storeMonitor *event.CommandMonitor
+	if cfg.Server.Metrics.Enabled {
+		m = metrics.New()
+		storeMonitor = m.StoreMonitor()
+	}
+
+	// the blog service needs its store before serving requests
+	var mongoClient *mongo.Client
+	var blogServer *blogservice.Server
+	if cfg.Server.Enabled(config.ServiceBlog) {
+		logger.Info("connecting to mongodb")
+		client, err := connectMongo(cfg.Mongo.URI, storeMonitor)
+		if err != nil {
+			return err
+		}
+		mongoClient = client
+		defer func() {
+			logger.Info("closing mongodb connection")
+			mongoClient.Disconnect(context.TODO())
+		}()
+
+		db := client.Database(cfg.Mongo.Database)
+		if cfg.Mongo.MigrateOnStart {
+			// bring the schema of existing documents up to date before serving requests
+			if err := blogservice.Migrate(db, blogCollections(cfg)); err != nil {
+				return fmt.Errorf("error while migrating blog store: %v", err)
+			}
+		}
+		blogServer = blogservice.NewServer(db, blogCollections(cfg))
+		blogServer.Start()
+		defer func() {
+			logger.Info("flushing blog views")
+			// the flush gets the time a shutdown gives in-flight calls
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+			defer cancel()
+			if err := blogServer.Close(ctx); err != nil {
+				logger.Error("error while flushing blog views", "error", err)
+			}
+		}()
+	}
+
+	logger.Info("setting up grpc server")
+
+	// Create TCP connection and do port binding
+	lis, err := net.Listen("tcp", cfg.Server.Address)
+	if err != nil {
+		return fmt.Errorf("Failed to listen tcp: %v", err)
+	}
+
+	calls := newCallTracker()
+	unary, stream, err := interceptors(cfg, logger, m, calls)
+	if err != nil {
+		lis.Close()
+		return err
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if cfg.Server.TLS.Enabled {
+		logger.Info("TLS is enabled")
+		if cfg.Server.TLS.ClientCAFile != "" {
+			logger.Info("mutual TLS is enabled", "require_client_cert", cfg.Server.TLS.RequireClientCert)
+		}
+		if cfg.Server.Connect {
+			// the HTTP server in front of the grpc server terminates TLS
+			tlsCfg, err := mtls.HTTPServerConfig(tlsOptions(cfg))
+			if err != nil {
+				lis.Close()
+				return err
+			}
+			lis = tls.NewListener(lis, tlsCfg)
+		} else {
+			// Setup SSL Encryption credentials for gRPC server over TLS
+			// with a client CA bundle configured, clients authenticate with their certificates (mutual TLS)
+			creds, err := mtls.ServerCredentials(tlsOptions(cfg))
+			if err != nil {
+				lis.Close()
+				return err
+			}
+			opts = append(opts, grpc.Creds(creds))
+		}
+	}
+
+	// grpc.health.v1 reports the status of each service, the blog service follows the reachability of its store
+	healthServer := newHealthServer(cfg)
+
+	// register the enabled services with a grpc server
+	// the v1 services are also registered under their names from before versioning, see legacyServices
+	register := func(s *grpc.Server) {
+		if cfg.Server.Enabled(config.ServiceGreet) {
+			registerWithLegacyName(s, &greetv1.GreetService_ServiceDesc, greetservice.NewServer())
+		}
+		if cfg.Server.Enabled(config.ServiceCalculator) {
+			registerWithLegacyName(s, &calculatorv1.CalculatorService_ServiceDesc, calculatorservice.NewServer())
+		}
+		if blogServer != nil {
+			// both versions of the blog service serve the same store
+			registerWithLegacyName(s, &blogv1.BlogService_ServiceDesc, blogServer.V1())
+			blogv2.RegisterBlogServiceServer(s, blogServer.V2())
+		}
+		// Register server with grpc-reflection
+		registerReflection(s)
+		healthpb.RegisterHealthServer(s, healthServer)
+	}
+
+	// Create GRPC server and register the enabled services with it
+	s := grpc.NewServer(opts...)
+	register(s)
+	servers := []stopper{s}
+	serve := func() error { return s.Serve(lis) }
+
+	// the REST gateway and the Connect handlers call the same services through an in-process grpc server
+	// with the same interceptors
+	var gw *gateway
+	if cfg.Server.HTTP.Enabled || cfg.Server.Connect {
+		inproc, err := newInProcess(unary, stream, register)
+		if err != nil {
+			lis.Close()
+			return err
+		}
+		defer inproc.close()
+		servers = append(servers, inproc.grpc)
+		if cfg.Server.Connect {
+			cs := newConnectServer(cfg, s, inproc)
+			servers[0] = cs
+			serve = func() error { return cs.serve(lis) }
+			logger.Info("serving the Connect protocol", "address", cfg.Server.Address)
+		}
+		if cfg.Server.HTTP.Enabled {
+			gw, err = newGateway(cfg, inproc)
+			if err != nil {
+				lis.Close()
+				return err
+			}
+			defer gw.close()
+			logger.Info("serving HTTP", "address", cfg.Server.HTTP.Address)
+		}
+	}
+
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	if mongoClient != nil {
+		go watchStore(watchCtx, healthServer, mongoClient, cfg.Server.HealthCheckInterval, logger)
+	}
+
+	if m != nil {
+		m.Initialize(s)
+		metricsServer, err := m.Serve(cfg.Server.Metrics.Address, cfg.Server.Metrics.Path)
+		if err != nil {
+			lis.Close()
+			return fmt.Errorf("Failed to serve metrics: %v", err)
+		}
+		logger.Info("serving metrics", "address", cfg.Server.Metrics.Address, "path", cfg.Server.Metrics.Path)
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			metricsServer.Shutdown(ctx)
+		}()
+	}
+
+	// Bind port with grpc server in its own go-routine
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info("starting the server", "address", cfg.Server.Address, "services", cfg.Server.Services)
+		serveErr <- serve()
+	}()
+
+	// wait for control+C or SIGTERM, sent by orchestrators like Kubernetes, for exit
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(ch)
+
+	// Block until a signal is received or the server fails
+	select {
+	case sig := <-ch:
+		logger.Info("received signal, shutting down", "signal", sig.String())
+	case err := <-serveErr:
+		return fmt.Errorf("Failed to serve: %v", err)
+	}
+
+	// load balancers stop sending calls once the health checks fail
+	logger.Info("reporting not serving")
+	stopWatching()
+	healthServer.Shutdown()
+	propagate(ch, cfg.Server.ShutdownDelay, logger)
+
+	if gw != nil {
+		// no new HTTP requests, those in flight are drained with the grpc calls they made
+		gw.stopAccepting()
+	}
+	drain(servers, calls, ch, cfg.Server.ShutdownTimeout, logger)
+	logger.Info("closing the listener")
+	lis.Close()
+	// the deferred functions close the blog store and the mongodb connection once no call uses them
+	return nil
+}
+
+// propagate keeps serving for delay after the health checks started failing, so that load balancers
+// stop sending calls before the server refuses them; another signal on sig ends the wait
+func propagate(sig <-chan os.Signal, delay time.Duration, logger *slog.Logger) {
+	if delay <= 0 {
+		return
+	}
+	logger.Info("serving until load balancers see the failing health checks", "delay", delay.String())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-sig:
+		logger.Warn("received second signal, skipping the shutdown delay")
+	}
+}
+
+// stopper is a server drain can stop, a grpc.Server or the connectServer in front of one
+type stopper interface {
+	// GracefulStop refuses new calls and returns once the calls in flight finished
+	GracefulStop()
+	// Stop cancels the calls in flight
+	Stop()
+}
+
+// drain stops servers gracefully: new calls are refused and the calls in flight may finish within timeout,
+// the ones still running after timeout, or after another signal on sig, are cancelled
+func drain(servers []stopper, calls *callTracker, sig <-chan os.Signal, timeout time.Duration, logger *slog.Logger) {
+	logger.Info("waiting for in-flight calls", "in_flight", calls.count(), "timeout", timeout.String())
+	stopped := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, s := range servers {
+			wg.Add(1)
+			go func(s stopper) {
+				defer wg.Done()
+				s.GracefulStop()
+			}(s)
+		}
+		wg.Wait()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+		logger.Info("all calls finished, server stopped")
+		return
+	case <-timer.C:
+		logger.Warn("shutdown timeout reached, cancelling in-flight calls", "in_flight", calls.count())
+	case <-sig:
+		logger.Warn("received another signal, cancelling in-flight calls", "in_flight", calls.count())
+	}
+	calls.log(logger, "cancelling in-flight call")
+	for _, s := range servers {
+		s.Stop()
+	}
+	<-stopped
+	logger.Info("server stopped")
+}
+
+// Migrate applies pending blog store migrations and returns
+func Migrate(cfg *config.Config) error {
+	if _, err := logging.Setup(logOptions(cfg)); err != nil {
+		return err
+	}
+	client, err := connectMongo(cfg.Mongo.URI, nil)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(context.TODO())
+
+	if err := blogservice.Migrate(client.Database(cfg.Mongo.Database), blogCollections(cfg)); err != nil {
+		return fmt.Errorf("error while migrating blog store: %v", err)
+	}
+	return nil
+}
+
+// blogCollections returns the configured collection names of the blog service
+func blogCollections(cfg *config.Config) blogservice.Collections {
+	return blogservice.Collections{
+		Blogs: cfg.Mongo.BlogCollection,
+		Views: cfg.Mongo.ViewCollection,
+	}
+}
+
+// logOptions returns the logging settings of cfg
+func logOptions(cfg *config.Config) logging.Options {
+	return logging.Options{
+		Level:    cfg.Log.Level,
+		Format:   cfg.Log.Format,
+		Payloads: cfg.Log.Payloads,
+		Redact:   cfg.Log.Redact,
+	}
+}
+
+// tracingOptions returns the tracing settings of cfg
+func tracingOptions(cfg *config.Config) tracing.Options {
+	return tracing.Options{
+		ServiceName:  cfg.Tracing.ServiceName,
+		Exporter:     cfg.Tracing.Exporter,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: cfg.Tracing.OTLPInsecure,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	}
+}
+
+// interceptors returns the unary and stream interceptors shared by all services, in call order
+// m records the metrics of the calls if not nil
+// calls tracks the calls in flight
+func interceptors(cfg *config.Config, logger *slog.Logger, m *metrics.Metrics, calls *callTracker) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+	deadlines := cfg.Server.Deadlines
+	deadlinePolicy, err := deadline.ParsePolicy(deadlines.Rules, deadline.Rule{Default: deadlines.Default, Max: deadlines.Max})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error in server.deadlines.rules: %v", err)
+	}
+	enforcer := deadline.New(deadline.Rule{Default: deadlines.Default, Max: deadlines.Max}, withV1Keys(deadlinePolicy))
+
+	// interceptors shared by all services, in call order
+	// stream calls of legacy services are renamed to their v1 method before any other interceptor sees them,
+	// tracing comes next so that the request log carries the trace id,
+	// the request log and metrics come before auth so that rejected calls are recorded too,
+	// recovery comes after them so that they see a panicking call fail with INTERNAL
+	requestLog := logging.NewInterceptor(logger, logOptions(cfg))
+	unary := []grpc.UnaryServerInterceptor{
+		tracing.UnaryServerInterceptor(), requestLog.UnaryServerInterceptor(), calls.UnaryServerInterceptor(),
+		enforcer.UnaryServerInterceptor(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		legacyNameStream,
+		tracing.StreamServerInterceptor(), requestLog.StreamServerInterceptor(), calls.StreamServerInterceptor(),
+		enforcer.StreamServerInterceptor(),
+	}
+	if m != nil {
+		unary = append(unary, m.UnaryServerInterceptor())
+		stream = append(stream, m.StreamServerInterceptor())
+	}
+	unary = append(unary, recovery.UnaryServerInterceptor())
+	stream = append(stream, recovery.StreamServerInterceptor())
+	if cfg.Server.Auth.Enabled {
+		logger.Info("bearer token authentication is enabled")
+		authenticator, err := auth.NewAuthenticator(auth.Options{
+			Keys: auth.KeyOptions{
+				HMACSecretFile: cfg.Server.Auth.HMACSecretFile,
+				PublicKeyFiles: cfg.Server.Auth.PublicKeyFiles,
+				JWKSFile:       cfg.Server.Auth.JWKSFile,
+			},
+			Issuer:   cfg.Server.Auth.Issuer,
+			Audience: cfg.Server.Auth.Audience,
+			Policy:   methodPolicy,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while loading token keys: %v", err)
+		}
+		unary = append(unary, authenticator.UnaryServerInterceptor())
+		stream = append(stream, authenticator.StreamServerInterceptor())
+	}
+	if cfg.Server.Limits.Enabled {
+		// after auth, so that authenticated callers are limited by token subject rather than address
+		policy, err := ratelimit.ParsePolicy(cfg.Server.Limits.Rules)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error in server.limits.rules: %v", err)
+		}
+		logger.Info("rate limits are enabled", "rules", len(policy))
+		limitOpts := ratelimit.Options{Policy: withV1Keys(policy)}
+		if m != nil {
+			limitOpts.OnReject = m.RateLimited
+		}
+		limiter := ratelimit.New(limitOpts)
+		unary = append(unary, limiter.UnaryServerInterceptor())
+		stream = append(stream, limiter.StreamServerInterceptor())
+	}
+	return unary, stream, nil
+}
+
+// tlsOptions returns the TLS settings of cfg shared by the grpc and HTTP listeners
+func tlsOptions(cfg *config.Config) mtls.ServerOptions {
+	tlsCfg := cfg.Server.TLS
+	return mtls.ServerOptions{
+		CertFile:          tlsCfg.CertFile,
+		KeyFile:           tlsCfg.KeyFile,
+		ClientCAFile:      tlsCfg.ClientCAFile,
+		RequireClientCert: tlsCfg.RequireClientCert,
+		ReloadInterval:    tlsCfg.ReloadInterval,
+	}
+}
+
+// connectMongo creates a client for the mongodb at uri and connects it
+// monitor, if not nil, observes every command sent to mongodb
+func connectMongo(uri string, monitor *event.CommandMonitor) (*mongo.Client, error) {
+	// Connect to mongodb : client is connection object to mongodb
+	clientOpts := options.Client().ApplyURI(uri)
+	if monitor != nil {
+		clientOpts.SetMonitor(monitor)
+	}
+	client, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating mongo-cleint: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("error while creating mongo-cleint-ctx: %v", err)
+	}
+	return client, nil
+}
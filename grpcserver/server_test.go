@@ -0,0 +1,67 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/rahulsingh/go-grpc-examples/config"
+	greetv1 "github.com/rahulsingh/go-grpc-examples/greet/v1"
+)
+
+// testServer is a grpc server on an in-memory connection, behind the interceptors of a configuration
+type testServer struct {
+	inproc *inProcess
+	conn   *grpc.ClientConn // client of the server
+	calls  *callTracker     // calls in flight on the server
+}
+
+// startTestServer serves the services added by register behind the interceptors of cfg,
+// the server is stopped at the end of the test
+func startTestServer(t *testing.T, cfg *config.Config, register func(*grpc.Server)) *testServer {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	calls := newCallTracker()
+	unary, stream, err := interceptors(cfg, logger, nil, calls)
+	if err != nil {
+		t.Fatalf("interceptors: %v", err)
+	}
+	p, err := newInProcess(unary, stream, register)
+	if err != nil {
+		t.Fatalf("newInProcess: %v", err)
+	}
+	t.Cleanup(p.close)
+	return &testServer{inproc: p, conn: p.conn, calls: calls}
+}
+
+// waitIdle fails the test unless the handlers of all calls return within timeout
+func (s *testServer) waitIdle(t *testing.T, timeout time.Duration) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for s.calls.count() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d calls still running after %v", s.calls.count(), timeout)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// checkServing fails the test unless the server answers a Greet call
+func (s *testServer) checkServing(t *testing.T) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := greetv1.NewGreetServiceClient(s.conn).Greet(ctx, &greetv1.GreetRequest{
+		Greeting: &greetv1.Greeting{FirstName: "Rahul", LastName: "Singh"},
+	})
+	if err != nil {
+		t.Fatalf("the server stopped answering: %v", err)
+	}
+	if res.GetResult() != "Hello Rahul Singh" {
+		t.Fatalf("Greet = %q, want Hello Rahul Singh", res.GetResult())
+	}
+}
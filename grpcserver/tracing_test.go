@@ -0,0 +1,80 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/rahulsingh/go-grpc-examples/blog/blogservice"
+	blogv2 "github.com/rahulsingh/go-grpc-examples/blog/v2"
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/tracing"
+)
+
+func TestTracingStoreSpanIsChildOfCallSpan(t *testing.T) {
+	spans, shutdown := tracing.SetupInMemory("grpcserver-test")
+	defer shutdown(context.Background())
+
+	// nothing listens on the port, the store operation fails once no server is selected
+	client, err := connectMongo("mongodb://127.0.0.1:1/?serverSelectionTimeoutMS=200", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect(context.Background())
+	store := blogservice.NewServer(client.Database("test"), blogservice.Collections{Blogs: "blogs", Views: "views"})
+
+	cfg := config.Default()
+	server := startTestServer(t, &cfg, func(s *grpc.Server) {
+		blogv2.RegisterBlogServiceServer(s, store.V2())
+	})
+	_, err = blogv2.NewBlogServiceClient(server.conn).GetBlog(context.Background(),
+		&blogv2.GetBlogRequest{BlogId: primitive.NewObjectID().Hex()})
+	if err == nil {
+		t.Fatal("GetBlog succeeded without mongodb")
+	}
+
+	call := findSpan(t, spans, "blog.v2.BlogService/GetBlog")
+	if call.SpanKind != trace.SpanKindServer {
+		t.Errorf("call span kind = %v, want server", call.SpanKind)
+	}
+	op := findSpan(t, spans, "blogs.findOne")
+	if op.Parent.SpanID() != call.SpanContext.SpanID() || op.SpanContext.TraceID() != call.SpanContext.TraceID() {
+		t.Errorf("store span %v is not a child of the call span %v", op.Parent, call.SpanContext)
+	}
+	if !hasAttribute(op.Attributes, attribute.String("db.system", "mongodb")) {
+		t.Errorf("store span attributes = %v, want db.system=mongodb", op.Attributes)
+	}
+	if op.Status.Code != otelcodes.Error {
+		t.Errorf("store span status = %v, want the error of the operation", op.Status)
+	}
+}
+
+// findSpan returns the single ended span called name
+func findSpan(t *testing.T, spans *tracetest.InMemoryExporter, name string) tracetest.SpanStub {
+	t.Helper()
+	var found []tracetest.SpanStub
+	for _, span := range spans.GetSpans() {
+		if span.Name == name {
+			found = append(found, span)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("found %d spans called %v, want 1", len(found), name)
+	}
+	return found[0]
+}
+
+func hasAttribute(attrs []attribute.KeyValue, want attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		if attr == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key carrying the request id, taken from the client if it sends one
+// and returned in the response headers
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLen bounds request ids chosen by clients
+const maxRequestIDLen = 64
+
+// Interceptor logs one line per call, plus the payloads of the messages if enabled
+type Interceptor struct {
+	logger   *slog.Logger
+	payloads bool
+	redact   map[string]bool
+}
+
+// NewInterceptor returns an interceptor logging to logger
+func NewInterceptor(logger *slog.Logger, opts Options) *Interceptor {
+	redact := map[string]bool{}
+	for _, field := range opts.Redact {
+		redact[field] = true
+	}
+	return &Interceptor{logger: logger, payloads: opts.Payloads, redact: redact}
+}
+
+// start returns the context of a call, carrying the logger of the call, and its request id
+func (i *Interceptor) start(ctx context.Context, method string) (context.Context, *slog.Logger, string) {
+	id := requestID(ctx)
+	logger := i.logger.With("request_id", id, "method", method)
+	// links the log lines of a call to its trace
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logger = logger.With("trace_id", sc.TraceID().String())
+	}
+	return NewContext(ctx, logger), logger, id
+}
+
+// finish logs the outcome of a call at a level depending on its status code
+func (i *Interceptor) finish(ctx context.Context, logger *slog.Logger, start time.Time, err error, attrs ...slog.Attr) {
+	st := status.Convert(err)
+	attrs = append([]slog.Attr{
+		slog.String("peer", peerAddress(ctx)),
+		slog.String("code", st.Code().String()),
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+	}, attrs...)
+	if err != nil {
+		attrs = append(attrs, slog.String("error", st.Message()))
+	}
+	logger.LogAttrs(ctx, levelOf(st.Code()), "finished call", attrs...)
+}
+
+// UnaryServerInterceptor logs unary calls
+func (i *Interceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx, logger, id := i.start(ctx, info.FullMethod)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+
+		resp, err := handler(ctx, req)
+
+		var attrs []slog.Attr
+		if i.payloads {
+			attrs = append(attrs, slog.Any("request", i.payload(req)))
+			if err == nil {
+				attrs = append(attrs, slog.Any("response", i.payload(resp)))
+			}
+		}
+		i.finish(ctx, logger, start, err, attrs...)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor logs streaming calls with the number of messages received and sent
+func (i *Interceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx, logger, id := i.start(ss.Context(), info.FullMethod)
+		ss.SetHeader(metadata.Pairs(RequestIDHeader, id))
+
+		stream := &loggingStream{ServerStream: ss, ctx: ctx, logger: logger, interceptor: i}
+		err := handler(srv, stream)
+
+		i.finish(ctx, logger, start, err,
+			slog.Int("messages_received", stream.received),
+			slog.Int("messages_sent", stream.sent),
+		)
+		return err
+	}
+}
+
+// loggingStream counts the messages of a stream and gives handlers the context carrying the logger
+type loggingStream struct {
+	grpc.ServerStream
+	ctx            context.Context
+	logger         *slog.Logger
+	interceptor    *Interceptor
+	received, sent int
+}
+
+func (s *loggingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *loggingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+		if s.interceptor.payloads {
+			s.logger.Debug("received message", "message", s.interceptor.payload(m))
+		}
+	}
+	return err
+}
+
+func (s *loggingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+		if s.interceptor.payloads {
+			s.logger.Debug("sent message", "message", s.interceptor.payload(m))
+		}
+	}
+	return err
+}
+
+// levelOf maps status codes to levels: client mistakes are warnings, server failures errors
+func levelOf(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
+
+// requestID returns the request id sent by the client, or a new random one
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 && ids[0] != "" && len(ids[0]) <= maxRequestIDLen {
+		return ids[0]
+	}
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
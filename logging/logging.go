@@ -0,0 +1,74 @@
+// Package logging sets up the structured, leveled logger of the servers
+// and logs every grpc call with an interceptor.
+//
+// Handlers log through FromContext, which returns the logger of the call
+// carrying its method and request id, so their lines can be joined with the request log.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Options configures the logger and the request log
+type Options struct {
+	Level    string   // debug, info, warn or error
+	Format   string   // json or text
+	Payloads bool     // log request and response messages
+	Redact   []string // message fields replaced by [REDACTED] in logged payloads, e.g. content
+}
+
+// ParseLevel converts a level name to a slog level
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q, use debug, info, warn or error", level)
+	}
+	return l, nil
+}
+
+// New returns a logger writing to w
+func New(w io.Writer, opts Options) (*slog.Logger, error) {
+	level, err := ParseLevel(opts.Level)
+	if err != nil {
+		return nil, err
+	}
+	handlerOpts := &slog.HandlerOptions{Level: level}
+	switch strings.ToLower(opts.Format) {
+	case "json", "":
+		return slog.New(slog.NewJSONHandler(w, handlerOpts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, handlerOpts)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q, use json or text", opts.Format)
+	}
+}
+
+// Setup makes a logger writing to stdout the default of slog and of the log package
+func Setup(opts Options) (*slog.Logger, error) {
+	logger, err := New(os.Stdout, opts)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(logger)
+	return logger, nil
+}
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx carrying logger
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger of the call handled with ctx, or the default logger outside of calls
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
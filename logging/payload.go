@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redacted replaces the value of redacted string fields
+const redacted = "[REDACTED]"
+
+// payload is a logged message, written as JSON by the JSON handler and as a JSON string by the text handler
+type payload struct {
+	json []byte
+}
+
+// payload converts a request or response message to its redacted JSON form
+func (i *Interceptor) payload(m interface{}) payload {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return payload{json: []byte(fmt.Sprintf("%q", fmt.Sprint(m)))}
+	}
+	if len(i.redact) > 0 {
+		msg = proto.Clone(msg)
+		redact(msg.ProtoReflect(), i.redact)
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return payload{json: []byte(fmt.Sprintf("%q", err.Error()))}
+	}
+	return payload{json: b}
+}
+
+func (p payload) MarshalJSON() ([]byte, error) {
+	return p.json, nil
+}
+
+func (p payload) MarshalText() ([]byte, error) {
+	return p.json, nil
+}
+
+// redact replaces, in m and its nested messages, the fields whose name is in fields
+// strings become [REDACTED], other values are cleared
+func redact(m protoreflect.Message, fields map[string]bool) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fields[string(fd.Name())] {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+			return true
+		}
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for j := 0; j < list.Len(); j++ {
+				redact(list.Get(j).Message(), fields)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message(), fields)
+					return true
+				})
+			}
+		default:
+			redact(v.Message(), fields)
+		}
+		return true
+	})
+}
@@ -0,0 +1,216 @@
+// Package metrics records Prometheus metrics of the grpc services and of the blog store
+// and serves them over HTTP for scraping.
+//
+// The grpc metrics follow the names of the go-grpc-prometheus middleware, so existing dashboards work:
+// grpc_server_started_total, grpc_server_handled_total, grpc_server_handling_seconds,
+// grpc_server_msg_received_total and grpc_server_msg_sent_total.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics holds the collectors of one server and the registry they are exposed from
+type Metrics struct {
+	registry *prometheus.Registry
+
+	started     *prometheus.CounterVec
+	handled     *prometheus.CounterVec
+	handling    *prometheus.HistogramVec
+	msgReceived *prometheus.CounterVec
+	msgSent     *prometheus.CounterVec
+
+	rateLimited *prometheus.CounterVec
+
+	storeDuration *prometheus.HistogramVec
+	storeErrors   *prometheus.CounterVec
+}
+
+// labels of the grpc metrics
+var rpcLabels = []string{"grpc_type", "grpc_service", "grpc_method"}
+
+// New creates the collectors and registers them, with the Go runtime and process collectors, on a new registry
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "Total number of RPCs started on the server.",
+		}, rpcLabels),
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+		}, append(rpcLabels, "grpc_code")),
+		handling: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Histogram of response latency (seconds) of RPCs handled by the server.",
+			Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, rpcLabels),
+		msgReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_msg_received_total",
+			Help: "Total number of RPC stream messages received on the server.",
+		}, rpcLabels),
+		msgSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_msg_sent_total",
+			Help: "Total number of RPC stream messages sent by the server.",
+		}, rpcLabels),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_rate_limited_total",
+			Help: "Total number of RPCs rejected with RESOURCE_EXHAUSTED by a rate or concurrency limit.",
+		}, []string{"grpc_service", "grpc_method", "limit"}),
+		storeDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "blog_store_command_duration_seconds",
+			Help:    "Histogram of the latency (seconds) of mongodb commands of the blog store.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"command"}),
+		storeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "blog_store_command_errors_total",
+			Help: "Total number of failed mongodb commands of the blog store.",
+		}, []string{"command"}),
+	}
+	m.registry.MustRegister(
+		m.started, m.handled, m.handling, m.msgReceived, m.msgSent, m.rateLimited,
+		m.storeDuration, m.storeErrors,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Initialize creates the series of every method registered on s with zero values,
+// so rates and alerts work before the first call of a method
+func (m *Metrics) Initialize(s *grpc.Server) {
+	for service, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			labels := []string{rpcType(method.IsClientStream, method.IsServerStream), service, method.Name}
+			m.started.WithLabelValues(labels...)
+			m.handling.WithLabelValues(labels...)
+			if method.IsClientStream || method.IsServerStream {
+				m.msgReceived.WithLabelValues(labels...)
+				m.msgSent.WithLabelValues(labels...)
+			}
+		}
+	}
+}
+
+// rpcType names the kind of a method like go-grpc-prometheus does
+func rpcType(clientStream, serverStream bool) string {
+	switch {
+	case clientStream && serverStream:
+		return "bidi_stream"
+	case clientStream:
+		return "client_stream"
+	case serverStream:
+		return "server_stream"
+	default:
+		return "unary"
+	}
+}
+
+// splitMethod splits /package.Service/Method into service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// UnaryServerInterceptor records the metrics of unary calls
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethod(info.FullMethod)
+		labels := []string{"unary", service, method}
+		m.started.WithLabelValues(labels...).Inc()
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.handling.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		m.handled.WithLabelValues(append(labels, status.Code(err).String())...).Inc()
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the metrics of streaming calls, including their messages
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethod(info.FullMethod)
+		labels := []string{rpcType(info.IsClientStream, info.IsServerStream), service, method}
+		m.started.WithLabelValues(labels...).Inc()
+		start := time.Now()
+
+		err := handler(srv, &countingStream{
+			ServerStream: ss,
+			received:     m.msgReceived.WithLabelValues(labels...),
+			sent:         m.msgSent.WithLabelValues(labels...),
+		})
+
+		m.handling.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		m.handled.WithLabelValues(append(labels, status.Code(err).String())...).Inc()
+		return err
+	}
+}
+
+// countingStream counts the messages of a stream
+type countingStream struct {
+	grpc.ServerStream
+	received, sent prometheus.Counter
+}
+
+func (s *countingStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.received.Inc()
+	}
+	return err
+}
+
+func (s *countingStream) SendMsg(msg interface{}) error {
+	err := s.ServerStream.SendMsg(msg)
+	if err == nil {
+		s.sent.Inc()
+	}
+	return err
+}
+
+// RateLimited counts a call of fullMethod rejected by limit, see ratelimit.Options.OnReject
+func (m *Metrics) RateLimited(fullMethod, limit string) {
+	service, method := splitMethod(fullMethod)
+	m.rateLimited.WithLabelValues(service, method, limit).Inc()
+}
+
+// Handler returns the HTTP handler exposing the metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Serve starts the HTTP server exposing the metrics on address at path
+// it returns once listening, serving continues until the server is shut down
+func (m *Metrics) Serve(address, path string) (*http.Server, error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, m.Handler())
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("metrics server failed", "error", err)
+		}
+	}()
+	return srv, nil
+}
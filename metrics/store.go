@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// StoreMonitor returns a mongodb command monitor recording the latency and errors of every command,
+// labelled by command name (find, insert, update, delete, aggregate, ...)
+// set it on the client options of the blog store with SetMonitor
+func (m *Metrics) StoreMonitor() *event.CommandMonitor {
+	// Succeeded and Failed events both report the duration, the started events are not needed
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			m.observeStore(e.CommandName, e.Duration, false)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			m.observeStore(e.CommandName, e.Duration, true)
+		},
+	}
+}
+
+func (m *Metrics) observeStore(command string, duration time.Duration, failed bool) {
+	m.storeDuration.WithLabelValues(command).Observe(duration.Seconds())
+	if failed {
+		m.storeErrors.WithLabelValues(command).Inc()
+	}
+}
@@ -0,0 +1,255 @@
+// Package mtls builds the TLS configuration of servers and clients, with optional mutual TLS.
+//
+// Server certificates and the client CA bundle are reloaded from disk when the files change,
+// so certificates can be rotated without restarting the server.
+// Handlers get the verified client certificate of the caller with IdentityFromContext.
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ServerOptions configures the TLS of a server
+type ServerOptions struct {
+	CertFile          string        // server certificate
+	KeyFile           string        // server private key
+	ClientCAFile      string        // CA bundle verifying client certificates, empty for server-side TLS only
+	RequireClientCert bool          // reject clients without a certificate signed by ClientCAFile
+	ReloadInterval    time.Duration // how often the files are checked for changes, 0 disables reloading
+}
+
+// ClientOptions configures the TLS of a client
+type ClientOptions struct {
+	CAFile     string // certificate authority trust certificate of the server
+	CertFile   string // client certificate presented for mutual TLS, optional
+	KeyFile    string // client private key
+	ServerName string // override of the server name verified in its certificate
+}
+
+// reloader keeps the server certificate and client CA pool loaded from disk,
+// reloading them on a handshake once ReloadInterval has passed and a file has changed
+type reloader struct {
+	opts ServerOptions
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+	checked   time.Time
+}
+
+// newReloader loads the files for the first time, failing if any of them is invalid
+func newReloader(opts ServerOptions) (*reloader, error) {
+	r := &reloader{opts: opts}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// files returns the files watched for changes
+func (r *reloader) files() []string {
+	files := []string{r.opts.CertFile, r.opts.KeyFile}
+	if r.opts.ClientCAFile != "" {
+		files = append(files, r.opts.ClientCAFile)
+	}
+	return files
+}
+
+// load reads certificate, key and client CA bundle and replaces the current ones on success
+// must be called with mu held, or before the reloader is shared
+func (r *reloader) load() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.opts.CertFile, r.opts.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed loading SSL certificate: %v", err)
+	}
+	var pool *x509.CertPool
+	if r.opts.ClientCAFile != "" {
+		if pool, err = loadPool(r.opts.ClientCAFile); err != nil {
+			return err
+		}
+	}
+
+	r.cert, r.clientCAs, r.modTimes = &cert, pool, modTimes
+	r.checked = time.Now()
+	return nil
+}
+
+// changed reports whether any watched file has a different modification time than when it was loaded
+func (r *reloader) changed() bool {
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			// a file being replaced may be missing for a moment, keep the current certificates
+			return false
+		}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// current returns the certificate and client CA pool, reloading them if they changed on disk
+func (r *reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.opts.ReloadInterval > 0 && time.Since(r.checked) >= r.opts.ReloadInterval {
+		r.checked = time.Now()
+		if r.changed() {
+			if err := r.load(); err != nil {
+				// keep serving with the previous certificates until the files are valid again
+				slog.Error("error while reloading TLS certificates, keeping the previous ones", "error", err)
+			} else {
+				slog.Info("reloaded TLS certificates")
+			}
+		}
+	}
+	return r.cert, r.clientCAs
+}
+
+// ServerCredentials returns grpc transport credentials for a server
+// with ClientCAFile set, client certificates are verified against it and, if RequireClientCert is set, required
+func ServerCredentials(opts ServerOptions) (credentials.TransportCredentials, error) {
+	cfg, err := serverConfig(opts, []string{"h2"})
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// HTTPServerConfig returns the TLS config of an HTTP server sharing the certificates of the grpc server,
+// serving HTTP/2 and HTTP/1.1
+func HTTPServerConfig(opts ServerOptions) (*tls.Config, error) {
+	return serverConfig(opts, []string{"h2", "http/1.1"})
+}
+
+// serverConfig returns a TLS config reloading the certificates of opts and negotiating protos
+func serverConfig(opts ServerOptions, protos []string) (*tls.Config, error) {
+	r, err := newReloader(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth := tls.NoClientCert
+	if opts.ClientCAFile != "" {
+		clientAuth = tls.VerifyClientCertIfGiven
+		if opts.RequireClientCert {
+			clientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	// every handshake gets a config with the current certificate and client CAs
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, clientCAs := r.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    clientCAs,
+				ClientAuth:   clientAuth,
+				NextProtos:   protos,
+			}, nil
+		},
+	}
+	return cfg, nil
+}
+
+// ClientCredentials returns grpc transport credentials for a client trusting CAFile
+// and presenting CertFile for mutual TLS if set
+func ClientCredentials(opts ClientOptions) (credentials.TransportCredentials, error) {
+	pool, err := loadPool(opts.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+		ServerName: opts.ServerName,
+	}
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error while loading client certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// loadPool reads a PEM bundle of CA certificates
+func loadPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading CA certificates: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificate found in %v", file)
+	}
+	return pool, nil
+}
+
+// Identity is the caller as proven by its verified client certificate
+type Identity struct {
+	Subject  string   // distinguished name, e.g. CN=greet-client,O=demo
+	DNSNames []string // DNS subject alternative names
+	URIs     []string // URI subject alternative names, e.g. spiffe://demo/greet-client
+	Emails   []string // email subject alternative names
+}
+
+// Name returns the most specific name of the identity: the first URI SAN, then DNS SAN, then the subject
+func (id *Identity) Name() string {
+	switch {
+	case len(id.URIs) > 0:
+		return id.URIs[0]
+	case len(id.DNSNames) > 0:
+		return id.DNSNames[0]
+	default:
+		return id.Subject
+	}
+}
+
+// IdentityFromContext returns the identity of the caller of a grpc handler
+// ok is false unless the caller presented a client certificate that the server verified
+func IdentityFromContext(ctx context.Context) (id *Identity, ok bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	cert := info.State.VerifiedChains[0][0]
+	id = &Identity{
+		Subject:  cert.Subject.String(),
+		DNSNames: cert.DNSNames,
+		Emails:   cert.EmailAddresses,
+	}
+	for _, uri := range cert.URIs {
+		id.URIs = append(id.URIs, uri.String())
+	}
+	return id, true
+}
@@ -0,0 +1,30 @@
+// Package openapi holds the OpenAPI v2 (Swagger) document of the REST gateway, generated by generate.sh
+// from the google.api.http options of blog.proto, calculator.proto and greet.proto.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// Path is where the servers serve the document on server.http.address
+const Path = "/openapi.json"
+
+// Spec is the OpenAPI v2 document of the BlogService, CalculatorService and GreetService endpoints
+//
+//go:embed api.swagger.json
+var Spec []byte
+
+// Handler serves Spec, to any origin so Swagger UI hosted elsewhere can load it
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Write(Spec)
+	})
+}
@@ -0,0 +1,33 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestOperationIDs checks that the operation ids of the merged document are unique, as OpenAPI requires
+// and as client generators need to name their methods
+func TestOperationIDs(t *testing.T) {
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(Spec, &spec); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]string{}
+	for path, operations := range spec.Paths {
+		for method, operation := range operations {
+			where := method + " " + path
+			if operation.OperationID == "" {
+				t.Errorf("%v has no operation id", where)
+				continue
+			}
+			if other, ok := seen[operation.OperationID]; ok {
+				t.Errorf("operation id %q of %v is also the one of %v", operation.OperationID, where, other)
+			}
+			seen[operation.OperationID] = where
+		}
+	}
+}
@@ -0,0 +1,295 @@
+// Package ratelimit keeps a single client from starving the others: calls are limited by token buckets
+// per method and per caller, and streams by a cap on how many of them a method serves at once.
+//
+// Calls over a limit fail with RESOURCE_EXHAUSTED carrying RetryInfo details,
+// so well behaved clients know how long to back off.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/rahulsingh/go-grpc-examples/auth"
+	"github.com/rahulsingh/go-grpc-examples/mtls"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// Limits reported to Options.OnReject and in the ErrorInfo metadata of rejected calls
+const (
+	LimitMethodRate = "method_rate" // Rule.Rate of the method, shared by all callers
+	LimitCallerRate = "caller_rate" // Rule.CallerRate of one caller
+	LimitStreams    = "streams"     // Rule.MaxStreams of the method
+)
+
+// streamRetryDelay is suggested to clients rejected by MaxStreams, how soon a stream ends is unknown
+const streamRetryDelay = time.Second
+
+// idleCallerTimeout is how long the bucket of a caller is kept after its last call
+const idleCallerTimeout = 10 * time.Minute
+
+// Rule limits the calls of a method, zero values mean no limit
+type Rule struct {
+	Rate        float64 // calls per second of the method, all callers together
+	Burst       int     // calls above Rate accepted at once, at least 1
+	CallerRate  float64 // calls per second of the method by one caller
+	CallerBurst int     // calls above CallerRate one caller may make at once, at least 1
+	MaxStreams  int     // streams of the method served at once, all callers together
+}
+
+// Policy maps full method names to rules like auth.Policy does.
+// Keys are either a full method, e.g. /blog.v1.BlogService/ListBlog, or all methods of a service, e.g. /blog.v1.BlogService/*.
+// Methods without a rule are not limited.
+type Policy map[string]Rule
+
+// rule returns the key and rule of fullMethod, preferring an exact match over the service wildcard
+func (p Policy) rule(fullMethod string) (string, Rule, bool) {
+	if rule, ok := p[fullMethod]; ok {
+		return fullMethod, rule, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		key := fullMethod[:i+1] + "*"
+		if rule, ok := p[key]; ok {
+			return key, rule, true
+		}
+	}
+	return "", Rule{}, false
+}
+
+// ParseRule parses a rule written as a method followed by its limits, separated by spaces, e.g.
+//
+//	/blog.v1.BlogService/ListBlog caller_rate=2 caller_burst=5 max_streams=20
+//
+// accepted limits are rate, burst, caller_rate, caller_burst and max_streams
+func ParseRule(s string) (string, Rule, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "/") {
+		return "", Rule{}, fmt.Errorf("rule %q must be a method like /package.Service/Method followed by limits", s)
+	}
+	var rule Rule
+	for _, field := range fields[1:] {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return "", Rule{}, fmt.Errorf("rule %q: limit %q must be name=value", s, field)
+		}
+		var err error
+		switch name {
+		case "rate":
+			rule.Rate, err = strconv.ParseFloat(value, 64)
+		case "burst":
+			rule.Burst, err = strconv.Atoi(value)
+		case "caller_rate":
+			rule.CallerRate, err = strconv.ParseFloat(value, 64)
+		case "caller_burst":
+			rule.CallerBurst, err = strconv.Atoi(value)
+		case "max_streams":
+			rule.MaxStreams, err = strconv.Atoi(value)
+		default:
+			return "", Rule{}, fmt.Errorf("rule %q: unknown limit %q", s, name)
+		}
+		if err != nil {
+			return "", Rule{}, fmt.Errorf("rule %q: %v: %v", s, name, err)
+		}
+	}
+	if rule.Rate < 0 || rule.Burst < 0 || rule.CallerRate < 0 || rule.CallerBurst < 0 || rule.MaxStreams < 0 {
+		return "", Rule{}, fmt.Errorf("rule %q: limits must not be negative", s)
+	}
+	return fields[0], rule, nil
+}
+
+// ParsePolicy parses rules written for ParseRule
+func ParsePolicy(rules []string) (Policy, error) {
+	policy := Policy{}
+	for _, s := range rules {
+		method, rule, err := ParseRule(s)
+		if err != nil {
+			return nil, err
+		}
+		policy[method] = rule
+	}
+	return policy, nil
+}
+
+// Options configures a Limiter
+type Options struct {
+	Policy Policy
+	// OnReject, if not nil, is called for every rejected call with its full method and the limit it hit
+	OnReject func(fullMethod, limit string)
+}
+
+// Limiter enforces a policy, its interceptors must come after authentication to limit callers by token subject
+type Limiter struct {
+	opts Options
+
+	mu        sync.Mutex
+	methods   map[string]*rate.Limiter // by policy key
+	callers   map[callerKey]*callerBucket
+	streams   map[string]int // streams in flight by policy key
+	lastSweep time.Time
+}
+
+// callerKey identifies the bucket of one caller for one policy key
+type callerKey struct {
+	rule   string
+	caller string
+}
+
+type callerBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// New returns a limiter enforcing opts.Policy
+func New(opts Options) *Limiter {
+	return &Limiter{
+		opts:      opts,
+		methods:   map[string]*rate.Limiter{},
+		callers:   map[callerKey]*callerBucket{},
+		streams:   map[string]int{},
+		lastSweep: time.Now(),
+	}
+}
+
+// Caller identifies the caller of a call: the subject of its bearer token, else the name in its client certificate,
+// else its IP address, so clients behind one address share their limits unless they authenticate
+func Caller(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok && claims.Subject != "" {
+		return "sub:" + claims.Subject
+	}
+	if id, ok := mtls.IdentityFromContext(ctx); ok {
+		return "cert:" + id.Name()
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+	return "unknown"
+}
+
+// allow takes a token from the buckets of the call, or returns the error rejecting it
+func (l *Limiter) allow(ctx context.Context, fullMethod string) error {
+	key, rule, ok := l.opts.Policy.rule(fullMethod)
+	if !ok || (rule.Rate == 0 && rule.CallerRate == 0) {
+		return nil
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	var buckets []*rate.Limiter
+	var limits []string
+	if rule.Rate > 0 {
+		bucket, ok := l.methods[key]
+		if !ok {
+			bucket = rate.NewLimiter(rate.Limit(rule.Rate), max(rule.Burst, 1))
+			l.methods[key] = bucket
+		}
+		buckets, limits = append(buckets, bucket), append(limits, LimitMethodRate)
+	}
+	if rule.CallerRate > 0 {
+		ck := callerKey{rule: key, caller: Caller(ctx)}
+		cb, ok := l.callers[ck]
+		if !ok {
+			cb = &callerBucket{limiter: rate.NewLimiter(rate.Limit(rule.CallerRate), max(rule.CallerBurst, 1))}
+			l.callers[ck] = cb
+		}
+		cb.lastUsed = now
+		buckets, limits = append(buckets, cb.limiter), append(limits, LimitCallerRate)
+	}
+	l.sweep(now)
+	l.mu.Unlock()
+
+	// reserve a token in every bucket, and give them all back if any bucket is empty,
+	// so a rejected call does not count against the other limits
+	reservations := make([]*rate.Reservation, 0, len(buckets))
+	for i, bucket := range buckets {
+		r := bucket.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			for _, taken := range reservations {
+				taken.CancelAt(now)
+			}
+			return l.reject(fullMethod, limits[i], rpcerr.ReasonRateLimited,
+				fmt.Sprintf("too many calls of %v, retry in %v", fullMethod, delay.Round(time.Millisecond)), delay)
+		}
+		reservations = append(reservations, r)
+	}
+	return nil
+}
+
+// sweep forgets the buckets of callers idle for idleCallerTimeout, at most once a minute, l.mu is held
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for key, cb := range l.callers {
+		if now.Sub(cb.lastUsed) > idleCallerTimeout {
+			delete(l.callers, key)
+		}
+	}
+}
+
+// beginStream counts a stream of fullMethod, returning the function to call once it ended
+// or the error rejecting it when the method already serves MaxStreams streams
+func (l *Limiter) beginStream(fullMethod string) (func(), error) {
+	key, rule, ok := l.opts.Policy.rule(fullMethod)
+	if !ok || rule.MaxStreams == 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.streams[key] >= rule.MaxStreams {
+		return nil, l.reject(fullMethod, LimitStreams, rpcerr.ReasonTooManyStreams,
+			fmt.Sprintf("too many concurrent streams of %v, at most %d", fullMethod, rule.MaxStreams), streamRetryDelay)
+	}
+	l.streams[key]++
+	return func() {
+		l.mu.Lock()
+		l.streams[key]--
+		l.mu.Unlock()
+	}, nil
+}
+
+// reject reports a rejected call and returns its RESOURCE_EXHAUSTED error
+func (l *Limiter) reject(fullMethod, limit, reason, msg string, retryDelay time.Duration) error {
+	if l.opts.OnReject != nil {
+		l.opts.OnReject(fullMethod, limit)
+	}
+	return rpcerr.ResourceExhausted(reason, msg, retryDelay, map[string]string{"method": fullMethod, "limit": limit})
+}
+
+// UnaryServerInterceptor limits the rate of unary calls
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits the rate of streaming calls and how many of them run at once
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		done, err := l.beginStream(info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer done()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,338 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/rahulsingh/go-grpc-examples/auth"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// slow is a rate whose buckets do not refill while a test runs, a token comes back after 1000s
+const slow = 0.001
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		rule       string
+		wantMethod string
+		want       Rule
+		wantErr    string
+	}{
+		{"/blog.v1.BlogService/ListBlog caller_rate=2 caller_burst=5 max_streams=20", "/blog.v1.BlogService/ListBlog",
+			Rule{CallerRate: 2, CallerBurst: 5, MaxStreams: 20}, ""},
+		{"  /blog.v1.BlogService/*   rate=0.5  burst=3 ", "/blog.v1.BlogService/*", Rule{Rate: 0.5, Burst: 3}, ""},
+		{"", "", Rule{}, "must be a method"},
+		{"/blog.v1.BlogService/ListBlog", "", Rule{}, "must be a method"},
+		{"blog.v1.BlogService/ListBlog rate=1", "", Rule{}, "must be a method"},
+		{"/blog.v1.BlogService/ListBlog rate", "", Rule{}, "must be name=value"},
+		{"/blog.v1.BlogService/ListBlog qps=1", "", Rule{}, `unknown limit "qps"`},
+		{"/blog.v1.BlogService/ListBlog burst=1.5", "", Rule{}, "burst"},
+		{"/blog.v1.BlogService/ListBlog rate=fast", "", Rule{}, "rate"},
+		{"/blog.v1.BlogService/ListBlog caller_rate=-1", "", Rule{}, "must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			method, rule, err := ParseRule(tt.rule)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseRule error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRule: %v", err)
+			}
+			if method != tt.wantMethod || rule != tt.want {
+				t.Errorf("ParseRule = %q %+v, want %q %+v", method, rule, tt.wantMethod, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyRule(t *testing.T) {
+	policy := Policy{
+		"/blog.v1.BlogService/*":        {Rate: 1},
+		"/blog.v1.BlogService/ListBlog": {MaxStreams: 2},
+		"/greet.v1.GreetService/Greet":  {CallerRate: 3},
+	}
+	tests := []struct {
+		method  string
+		wantKey string
+		ok      bool
+	}{
+		{"/blog.v1.BlogService/ListBlog", "/blog.v1.BlogService/ListBlog", true},
+		{"/blog.v1.BlogService/ReadBlog", "/blog.v1.BlogService/*", true},
+		{"/greet.v1.GreetService/Greet", "/greet.v1.GreetService/Greet", true},
+		{"/greet.v1.GreetService/LongGreet", "", false},
+		{"/blog.v2.BlogService/GetBlog", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			key, rule, ok := policy.rule(tt.method)
+			if key != tt.wantKey || ok != tt.ok {
+				t.Fatalf("rule(%v) = %q, %v, want %q, %v", tt.method, key, ok, tt.wantKey, tt.ok)
+			}
+			if ok && rule != policy[key] {
+				t.Errorf("rule(%v) = %+v, want the rule of %v", tt.method, rule, key)
+			}
+		})
+	}
+}
+
+// callerContext returns the context of a call from the given IP address
+func callerContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+}
+
+func TestCaller(t *testing.T) {
+	ctx := callerContext("10.0.0.1")
+	if got := Caller(ctx); got != "ip:10.0.0.1" {
+		t.Errorf("Caller = %q, want ip:10.0.0.1", got)
+	}
+	ctx = auth.NewContext(ctx, &auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "alice"}})
+	if got := Caller(ctx); got != "sub:alice" {
+		t.Errorf("Caller with claims = %q, want sub:alice", got)
+	}
+	if got := Caller(context.Background()); got != "unknown" {
+		t.Errorf("Caller without peer = %q, want unknown", got)
+	}
+}
+
+// TestAllow checks which calls the buckets accept and that a rejected call gives back the tokens it reserved
+func TestAllow(t *testing.T) {
+	const method = "/greet.v1.GreetService/Greet"
+	type call struct {
+		caller    string
+		wantLimit string // limit rejecting the call, none if accepted
+	}
+	tests := []struct {
+		name  string
+		rule  Rule
+		calls []call
+	}{
+		{"method rate shared by callers", Rule{Rate: slow, Burst: 2}, []call{
+			{"10.0.0.1", ""}, {"10.0.0.2", ""}, {"10.0.0.1", LimitMethodRate}, {"10.0.0.3", LimitMethodRate},
+		}},
+		{"caller rate per caller", Rule{CallerRate: slow, CallerBurst: 1}, []call{
+			{"10.0.0.1", ""}, {"10.0.0.1", LimitCallerRate}, {"10.0.0.2", ""}, {"10.0.0.2", LimitCallerRate},
+		}},
+		{"burst is at least 1", Rule{Rate: slow}, []call{
+			{"10.0.0.1", ""}, {"10.0.0.2", LimitMethodRate},
+		}},
+		// the calls of 10.0.0.1 rejected by its caller rate do not take the last token of the method,
+		// 10.0.0.2 still gets it
+		{"caller rejection gives the method token back", Rule{Rate: slow, Burst: 2, CallerRate: slow, CallerBurst: 1}, []call{
+			{"10.0.0.1", ""}, {"10.0.0.1", LimitCallerRate}, {"10.0.0.1", LimitCallerRate}, {"10.0.0.2", ""},
+			{"10.0.0.3", LimitMethodRate},
+		}},
+		// the call of 10.0.0.2 rejected by the method rate does not take its caller token
+		{"method rejection gives the caller token back", Rule{Rate: slow, Burst: 1, CallerRate: slow, CallerBurst: 1}, []call{
+			{"10.0.0.1", ""}, {"10.0.0.2", LimitMethodRate},
+		}},
+		{"no rate", Rule{MaxStreams: 1}, []call{{"10.0.0.1", ""}, {"10.0.0.1", ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rejected []string
+			l := New(Options{
+				Policy:   Policy{method: tt.rule},
+				OnReject: func(fullMethod, limit string) { rejected = append(rejected, limit) },
+			})
+			var wantRejected []string
+			for i, c := range tt.calls {
+				err := l.allow(callerContext(c.caller), method)
+				if c.wantLimit == "" {
+					if err != nil {
+						t.Fatalf("call %d by %v: %v", i, c.caller, err)
+					}
+					continue
+				}
+				wantRejected = append(wantRejected, c.wantLimit)
+				if got := rejectedLimit(t, err); got != c.wantLimit {
+					t.Fatalf("call %d by %v rejected by %q, want %q", i, c.caller, got, c.wantLimit)
+				}
+			}
+			if !reflect.DeepEqual(rejected, wantRejected) {
+				t.Errorf("OnReject got %q, want %q", rejected, wantRejected)
+			}
+		})
+	}
+
+	// the caller token kept by the rejected call of 10.0.0.2 is still there once the method bucket refills
+	l := New(Options{Policy: Policy{method: {Rate: slow, Burst: 1, CallerRate: slow, CallerBurst: 1}}})
+	if err := l.allow(callerContext("10.0.0.1"), method); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.allow(callerContext("10.0.0.2"), method); err == nil {
+		t.Fatal("second call accepted, want method_rate")
+	}
+	if tokens := l.callers[callerKey{rule: method, caller: "ip:10.0.0.2"}].limiter.Tokens(); tokens < 0.99 {
+		t.Errorf("caller bucket of the rejected call has %v tokens, want 1", tokens)
+	}
+}
+
+// rejectedLimit returns the limit in the ErrorInfo of a RESOURCE_EXHAUSTED error
+func rejectedLimit(t *testing.T, err error) string {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("error %v, want RESOURCE_EXHAUSTED", err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetMetadata()["limit"]
+		}
+	}
+	t.Fatalf("error %v without ErrorInfo", err)
+	return ""
+}
+
+// retryDelay returns the delay of the RetryInfo of err
+func retryDelay(t *testing.T, err error) time.Duration {
+	t.Helper()
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration()
+		}
+	}
+	t.Fatalf("error %v without RetryInfo", err)
+	return 0
+}
+
+func TestRetryInfo(t *testing.T) {
+	const method = "/greet.v1.GreetService/Greet"
+	tests := []struct {
+		name     string
+		rule     Rule
+		min, max time.Duration
+	}{
+		{"one call per second", Rule{Rate: 1}, 900 * time.Millisecond, time.Second},
+		{"ten calls per second", Rule{Rate: 10}, 90 * time.Millisecond, 100 * time.Millisecond},
+		{"caller rate", Rule{CallerRate: 0.5}, 1900 * time.Millisecond, 2 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(Options{Policy: Policy{method: tt.rule}})
+			ctx := callerContext("10.0.0.1")
+			if err := l.allow(ctx, method); err != nil {
+				t.Fatal(err)
+			}
+			err := l.allow(ctx, method)
+			if reason := rpcerr.Reason(err); reason != rpcerr.ReasonRateLimited {
+				t.Errorf("reason %q, want %v", reason, rpcerr.ReasonRateLimited)
+			}
+			if delay := retryDelay(t, err); delay < tt.min || delay > tt.max {
+				t.Errorf("retry delay %v, want between %v and %v", delay, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestBeginStream(t *testing.T) {
+	var rejected []string
+	l := New(Options{
+		Policy: Policy{
+			"/blog.v1.BlogService/*":        {MaxStreams: 2},
+			"/greet.v1.GreetService/Greet":  {Rate: 1},
+			"/blog.v1.BlogService/ListBlog": {MaxStreams: 1},
+		},
+		OnReject: func(fullMethod, limit string) { rejected = append(rejected, fullMethod+" "+limit) },
+	})
+
+	// the methods of the wildcard share its streams
+	done1, err := l.beginStream("/blog.v1.BlogService/ListPopularBlogs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done2, err := l.beginStream("/blog.v1.BlogService/GetRelatedBlogs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.beginStream("/blog.v1.BlogService/ListPopularBlogs")
+	if got := rejectedLimit(t, err); got != LimitStreams {
+		t.Errorf("third stream rejected by %q, want %v", got, LimitStreams)
+	}
+	if reason := rpcerr.Reason(err); reason != rpcerr.ReasonTooManyStreams {
+		t.Errorf("reason %q, want %v", reason, rpcerr.ReasonTooManyStreams)
+	}
+	if delay := retryDelay(t, err); delay != streamRetryDelay {
+		t.Errorf("retry delay %v, want %v", delay, streamRetryDelay)
+	}
+
+	// an exact rule counts its streams apart from the wildcard
+	doneList, err := l.beginStream("/blog.v1.BlogService/ListBlog")
+	if err != nil {
+		t.Fatalf("ListBlog stream beside the wildcard ones: %v", err)
+	}
+	if _, err := l.beginStream("/blog.v1.BlogService/ListBlog"); err == nil {
+		t.Error("second ListBlog stream accepted, want at most 1")
+	}
+
+	// an ended stream frees its place
+	done1()
+	done3, err := l.beginStream("/blog.v1.BlogService/ListPopularBlogs")
+	if err != nil {
+		t.Fatalf("stream after one ended: %v", err)
+	}
+	done2()
+	done3()
+	doneList()
+	if l.streams["/blog.v1.BlogService/*"] != 0 || l.streams["/blog.v1.BlogService/ListBlog"] != 0 {
+		t.Errorf("streams in flight %v after all ended, want none", l.streams)
+	}
+
+	// methods without MaxStreams are not counted
+	for i := 0; i < 3; i++ {
+		done, err := l.beginStream("/greet.v1.GreetService/Greet")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer done()
+	}
+	if _, ok := l.streams["/greet.v1.GreetService/Greet"]; ok {
+		t.Error("streams of a method without MaxStreams are counted")
+	}
+
+	want := []string{"/blog.v1.BlogService/ListPopularBlogs streams", "/blog.v1.BlogService/ListBlog streams"}
+	if !reflect.DeepEqual(rejected, want) {
+		t.Errorf("OnReject got %q, want %q", rejected, want)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	l := New(Options{})
+	now := time.Now()
+	idle := callerKey{rule: "/greet.v1.GreetService/Greet", caller: "ip:10.0.0.1"}
+	active := callerKey{rule: "/greet.v1.GreetService/Greet", caller: "ip:10.0.0.2"}
+	l.callers[idle] = &callerBucket{lastUsed: now.Add(-idleCallerTimeout - time.Second)}
+	l.callers[active] = &callerBucket{lastUsed: now.Add(-idleCallerTimeout + time.Second)}
+
+	// at most once a minute
+	l.lastSweep = now.Add(-30 * time.Second)
+	l.sweep(now)
+	if len(l.callers) != 2 {
+		t.Fatalf("swept %d callers within a minute of the last sweep", 2-len(l.callers))
+	}
+
+	l.lastSweep = now.Add(-time.Minute)
+	l.sweep(now)
+	if _, ok := l.callers[idle]; ok {
+		t.Error("idle caller kept")
+	}
+	if _, ok := l.callers[active]; !ok {
+		t.Error("active caller swept")
+	}
+	if !l.lastSweep.Equal(now) {
+		t.Errorf("last sweep %v, want %v", l.lastSweep, now)
+	}
+}
@@ -0,0 +1,49 @@
+// Package recovery turns panics of grpc handlers into INTERNAL errors, so one bad call
+// fails on its own instead of taking the whole server, and every other call, down with it.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+
+	"github.com/rahulsingh/go-grpc-examples/logging"
+	"github.com/rahulsingh/go-grpc-examples/rpcerr"
+)
+
+// recovered logs the panic p with its stack trace on the logger of the call
+// and returns the error sent to the client, which does not reveal the panic
+func recovered(ctx context.Context, method string, p interface{}) error {
+	logging.FromContext(ctx).Error("recovered from panic in handler",
+		"panic", fmt.Sprint(p),
+		"stack", string(debug.Stack()),
+	)
+	return rpcerr.Internal(rpcerr.ReasonInternal, "internal error while handling "+method)
+}
+
+// UnaryServerInterceptor recovers from panics of unary handlers and the interceptors after it
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				resp, err = nil, recovered(ctx, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor recovers from panics of streaming handlers and the interceptors after it
+// panics of goroutines started by a handler cannot be recovered here
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(ss.Context(), info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
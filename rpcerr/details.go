@@ -0,0 +1,84 @@
+package rpcerr
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// details returns the decoded google.rpc details of a gRPC error, nil for any other error
+func details(err error) []interface{} {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil
+	}
+	return st.Details()
+}
+
+// Info returns the ErrorInfo attached to err, or nil if there is none
+func Info(err error) *errdetails.ErrorInfo {
+	for _, detail := range details(err) {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// Reason returns the ErrorInfo reason of err, or "" if err carries no ErrorInfo
+func Reason(err error) string {
+	return Info(err).GetReason()
+}
+
+// HasReason reports whether err was returned by these services with the given reason
+func HasReason(err error, reason string) bool {
+	info := Info(err)
+	return info != nil && info.GetDomain() == Domain && info.GetReason() == reason
+}
+
+// FieldViolations returns all BadRequest field violations attached to err
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range details(err) {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = append(violations, badRequest.GetFieldViolations()...)
+		}
+	}
+	return violations
+}
+
+// FieldErrors maps the field violations of err to field -> description,
+// joining the descriptions of a field that failed several rules
+func FieldErrors(err error) map[string]string {
+	fields := map[string]string{}
+	for _, violation := range FieldViolations(err) {
+		if previous, ok := fields[violation.GetField()]; ok {
+			fields[violation.GetField()] = previous + "; " + violation.GetDescription()
+			continue
+		}
+		fields[violation.GetField()] = violation.GetDescription()
+	}
+	return fields
+}
+
+// Resource returns the ResourceInfo attached to err, or nil if there is none
+func Resource(err error) *errdetails.ResourceInfo {
+	for _, detail := range details(err) {
+		if resource, ok := detail.(*errdetails.ResourceInfo); ok {
+			return resource
+		}
+	}
+	return nil
+}
+
+// RetryDelay returns the delay suggested by the RetryInfo attached to err
+// ok is false if the server did not ask for a retry
+func RetryDelay(err error) (delay time.Duration, ok bool) {
+	for _, detail := range details(err) {
+		if retry, isRetry := detail.(*errdetails.RetryInfo); isRetry {
+			return retry.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
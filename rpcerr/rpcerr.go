@@ -0,0 +1,191 @@
+// Package rpcerr builds gRPC status errors carrying google.rpc error details
+// so that callers can branch on a stable, machine-readable reason instead of parsing messages.
+//
+// Every error created by this package carries an ErrorInfo with Domain and one of the Reason* constants,
+// plus BadRequest, ResourceInfo or RetryInfo details depending on the kind of failure.
+// The helpers in details.go unpack them again on the client side.
+package rpcerr
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is the ErrorInfo domain of all errors returned by the services of this repository
+const Domain = "go-grpc-examples.rahulsingh.github.com"
+
+// Stable error reasons reported in ErrorInfo.reason
+// these values are part of the API and must never change once released
+const (
+	ReasonInvalidArgument = "INVALID_ARGUMENT"  // request failed validation, see BadRequest details
+	ReasonInvalidID       = "INVALID_ID"        // resource id cannot be parsed
+	ReasonNotFound        = "NOT_FOUND"         // resource does not exist, see ResourceInfo details
+	ReasonVersionMismatch = "VERSION_MISMATCH"  // resource changed since the version the request is based on
+	ReasonAlreadyExists   = "ALREADY_EXISTS"    // another resource has the same unique key, see ResourceInfo details
+	ReasonNegativeNumber  = "NEGATIVE_NUMBER"   // number must not be negative
+	ReasonCanceled        = "CANCELED"          // client canceled the request
+	ReasonDeadline        = "DEADLINE_EXCEEDED" // deadline of the request expired before it completed
+	ReasonMalformed       = "MALFORMED_MESSAGE" // streamed message could not be decoded
+	ReasonRateLimited     = "RATE_LIMITED"      // too many calls of the method, see RetryInfo details
+	ReasonTooManyStreams  = "TOO_MANY_STREAMS"  // too many concurrent streams of the method, see RetryInfo details
+	ReasonStoreFailure    = "STORE_FAILURE"     // storage failed permanently for this request
+	ReasonStoreTransient  = "STORE_UNAVAILABLE" // storage failed transiently, see RetryInfo details
+	ReasonInternal        = "INTERNAL"          // unexpected server side failure
+	ReasonMissingToken    = "MISSING_TOKEN"     // no bearer token in the request metadata
+	ReasonInvalidToken    = "INVALID_TOKEN"     // bearer token malformed, expired or wrongly signed
+	ReasonMissingScope    = "MISSING_SCOPE"     // caller lacks a scope required by the method
+	ReasonMissingRole     = "MISSING_ROLE"      // caller has none of the roles allowed to call the method
+)
+
+// New returns a status error with the given code and message, carrying an ErrorInfo
+// for reason and metadata followed by any additional details
+func New(code codes.Code, reason, msg string, metadata map[string]string, details ...proto.Message) error {
+	st := status.New(code, msg)
+	// status still takes the messages of the deprecated protobuf API
+	all := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	}}
+	for _, detail := range details {
+		all = append(all, protoadapt.MessageV1Of(detail))
+	}
+
+	detailed, err := st.WithDetails(all...)
+	if err != nil {
+		// details could not be attached, still report the code and message
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// FieldViolation describes a single invalid field of a request
+func FieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	}
+}
+
+// InvalidArgument returns an INVALID_ARGUMENT error with BadRequest details listing the violations
+func InvalidArgument(reason, msg string, violations ...*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return New(codes.InvalidArgument, reason, msg, nil)
+	}
+	return New(codes.InvalidArgument, reason, msg, nil, &errdetails.BadRequest{FieldViolations: violations})
+}
+
+// NotFound returns a NOT_FOUND error with ResourceInfo details for the missing resource
+func NotFound(resourceType, resourceName, msg string) error {
+	return New(codes.NotFound, ReasonNotFound, msg,
+		map[string]string{"resource_type": resourceType},
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+			Description:  msg,
+		},
+	)
+}
+
+// AlreadyExists returns an ALREADY_EXISTS error with ResourceInfo details for the resource holding the unique key
+func AlreadyExists(resourceType, resourceName, msg string) error {
+	return New(codes.AlreadyExists, ReasonAlreadyExists, msg,
+		map[string]string{"resource_type": resourceType},
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+			Description:  msg,
+		},
+	)
+}
+
+// Aborted returns an ABORTED error for a change conflicting with a concurrent one,
+// the client should read the resource again before retrying
+func Aborted(reason, msg string, metadata map[string]string) error {
+	return New(codes.Aborted, reason, msg, metadata)
+}
+
+// Transient returns an UNAVAILABLE error with RetryInfo details telling the client when to retry
+func Transient(reason, msg string, retryDelay time.Duration) error {
+	return New(codes.Unavailable, reason, msg, nil, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+}
+
+// ResourceExhausted returns a RESOURCE_EXHAUSTED error for calls over a limit,
+// with RetryInfo details telling the client when to retry
+func ResourceExhausted(reason, msg string, retryDelay time.Duration, metadata map[string]string) error {
+	return New(codes.ResourceExhausted, reason, msg, metadata, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+}
+
+// Internal returns an INTERNAL error for failures the client cannot act on
+func Internal(reason, msg string) error {
+	return New(codes.Internal, reason, msg, nil)
+}
+
+// Canceled returns a CANCELED error for requests abandoned by the client
+func Canceled(msg string) error {
+	return New(codes.Canceled, ReasonCanceled, msg, nil)
+}
+
+// Unauthenticated returns an UNAUTHENTICATED error for requests without valid credentials
+func Unauthenticated(reason, msg string) error {
+	return New(codes.Unauthenticated, reason, msg, nil)
+}
+
+// PermissionDenied returns a PERMISSION_DENIED error for an authenticated caller not allowed to call method
+func PermissionDenied(reason, msg, method string, metadata map[string]string) error {
+	all := map[string]string{"method": method}
+	for k, v := range metadata {
+		all[k] = v
+	}
+	return New(codes.PermissionDenied, reason, msg, all)
+}
+
+// DeadlineExceeded returns a DEADLINE_EXCEEDED error for requests whose deadline expired
+func DeadlineExceeded(msg string) error {
+	return New(codes.DeadlineExceeded, ReasonDeadline, msg, nil)
+}
+
+// Context returns CANCELED or DEADLINE_EXCEEDED once ctx is done, with msg describing the abandoned work,
+// and nil while the call may go on
+func Context(ctx context.Context, msg string) error {
+	switch ctx.Err() {
+	case nil:
+		return nil
+	case context.DeadlineExceeded:
+		return DeadlineExceeded(msg + ": deadline exceeded")
+	default:
+		return Canceled(msg + ": the client has canceled the request")
+	}
+}
+
+// Stream returns the status a handler returns when Recv or Send on its stream failed with err,
+// msg describing what the handler was doing, e.g. "error while reading client stream"
+// a client that canceled or ran out of time gets CANCELED or DEADLINE_EXCEEDED,
+// a message that could not be decoded INVALID_ARGUMENT and anything else INTERNAL
+func Stream(err error, msg string) error {
+	msg = msg + ": " + status.Convert(err).Message()
+	switch code := status.Code(err); {
+	case code == codes.Canceled || errors.Is(err, context.Canceled):
+		return Canceled(msg)
+	case code == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded):
+		return DeadlineExceeded(msg)
+	case code == codes.Internal && strings.Contains(err.Error(), "failed to unmarshal"):
+		// grpc reports undecodable messages as INTERNAL, but the client sent them
+		return InvalidArgument(ReasonMalformed, msg)
+	default:
+		return Internal(ReasonInternal, msg)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"log"
+
+	"github.com/rahulsingh/go-grpc-examples/config"
+	"github.com/rahulsingh/go-grpc-examples/grpcserver"
+)
+
+// server hosts any combination of the greet, calculator and blog services on one port
+//
+//	server --server.services=greet,calculator,blog --server.address=0.0.0.0:50051
+//	server --config=server.yaml
+//	server --print-config
+//	server migrate
+func main() {
+	// stetting the log level ,if we crash go code we get the file name and line number
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	cfg := config.Default()
+	args := config.MustLoad(&cfg)
+
+	if len(args) > 0 && args[0] == "migrate" {
+		if err := grpcserver.Migrate(&cfg); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
+	if err := grpcserver.Run(&cfg); err != nil {
+		log.Fatalf("Failed to run server: %v", err)
+	}
+}
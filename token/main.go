@@ -0,0 +1,93 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rahulsingh/go-grpc-examples/auth"
+	"github.com/rahulsingh/go-grpc-examples/devca"
+)
+
+// token creates the JWT bearer tokens the clients send when server.auth is enabled
+//
+//	token secret                                        random HMAC secret in ssl/jwt.secret
+//	token --subject=alice --scopes=blog.read,blog.write signed with ssl/jwt.secret
+//	token --subject=admin --scopes=blog.write --roles=admin --key-file=signer.pem --kid=key-1
+//
+// Print it to a file and use it with --client.auth.token_file, or pass it with --client.auth.token.
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) > 1 && os.Args[1] == "secret" {
+		fs := flag.NewFlagSet("token secret", flag.ExitOnError)
+		out := fs.String("out", "ssl/jwt.secret", "file receiving the secret")
+		fs.Parse(os.Args[2:])
+		if err := newSecret(*out); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
+	fs := flag.NewFlagSet("token", flag.ExitOnError)
+	subject := fs.String("subject", "demo-client", "sub claim")
+	scopes := fs.String("scopes", "blog.read,blog.write", "comma separated scopes")
+	roles := fs.String("roles", "", "comma separated roles, e.g. admin")
+	ttl := fs.Duration("ttl", time.Hour, "validity of the token")
+	issuer := fs.String("issuer", "go-grpc-examples", "iss claim, must match server.auth.issuer")
+	audience := fs.String("audience", "go-grpc-examples", "aud claim, must match server.auth.audience")
+	secretFile := fs.String("hmac-secret-file", "ssl/jwt.secret", "HMAC secret signing HS256 tokens, ignored with --key-file")
+	keyFile := fs.String("key-file", "", "PEM RSA or ECDSA private key signing RS256/ES* tokens")
+	kid := fs.String("kid", "", "key id put in the token header, matching a key of server.auth.jwks_file")
+	out := fs.String("out", "", "file receiving the token, stdout if empty")
+	fs.Parse(os.Args[1:])
+
+	claims := auth.NewClaims(*subject, *issuer, *audience, *ttl, split(*scopes), split(*roles))
+	var token string
+	var err error
+	if *keyFile != "" {
+		token, err = auth.Sign(claims, *keyFile, *kid, false)
+	} else {
+		token, err = auth.Sign(claims, *secretFile, *kid, true)
+	}
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	if *out == "" {
+		fmt.Println(token)
+		return
+	}
+	if err := devca.WriteFile(*out, []byte(token+"\n"), 0600); err != nil {
+		log.Fatalf("%v", err)
+	}
+	fmt.Printf("Wrote token for %v to %v, valid until %v\n", *subject, *out, claims.ExpiresAt.Format(time.RFC3339))
+}
+
+// newSecret writes a random 256 bit HMAC secret
+func newSecret(out string) error {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return err
+	}
+	if err := devca.WriteFile(out, []byte(base64.RawURLEncoding.EncodeToString(secret)+"\n"), 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote HMAC secret to %v, use it with --server.auth.hmac_secret_file=%v\n", out, out)
+	return nil
+}
+
+func split(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
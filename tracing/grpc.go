@@ -0,0 +1,272 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// streamed messages are grouped into batch spans of at most batchSize messages
+// or of the messages exchanged within batchWindow, whichever comes first
+const (
+	batchSize   = 50
+	batchWindow = time.Second
+)
+
+// metadataCarrier adapts grpc metadata to the otel propagators
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// spanName and attributes of a full method name /package.Service/Method
+func rpcSpan(fullMethod string) (string, []attribute.KeyValue) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	service, method, _ := strings.Cut(name, "/")
+	return name, []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", method),
+	}
+}
+
+// finishRPC records the status code of a call on its span
+// on the server, only codes meaning a server failure mark the span as an error
+func finishRPC(span trace.Span, err error, server bool) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(code)))
+	if err == nil {
+		return
+	}
+	if server {
+		switch code {
+		case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		default:
+			return
+		}
+	}
+	span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+}
+
+// recordError marks span as failed with err, nothing if err is nil
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+}
+
+// startServerSpan continues the trace of the client, if it sent one, with the span of the call
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md.Copy()))
+	name, attrs := rpcSpan(fullMethod)
+	return tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+}
+
+// UnaryServerInterceptor traces unary calls
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		finishRPC(span, err, true)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor traces streaming calls, with a child span per batch of messages
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer span.End()
+
+		stream := &tracedServerStream{ServerStream: ss, ctx: ctx, name: strings.TrimPrefix(info.FullMethod, "/")}
+		err := handler(srv, stream)
+		stream.endBatch()
+		span.SetAttributes(
+			attribute.Int("rpc.messages_received", stream.totalReceived),
+			attribute.Int("rpc.messages_sent", stream.totalSent),
+		)
+		finishRPC(span, err, true)
+		return err
+	}
+}
+
+// tracedServerStream gives handlers the context of the call span and groups messages into batch spans
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	name string
+
+	batch          trace.Span // nil between batches
+	batchStart     time.Time
+	batchIndex     int
+	received, sent int // messages of the current batch
+
+	totalReceived, totalSent int
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// message counts a message in the current batch, starting a new batch when the current one is full or old
+func (s *tracedServerStream) message(received bool) {
+	if s.batch != nil && (s.received+s.sent >= batchSize || time.Since(s.batchStart) > batchWindow) {
+		s.endBatch()
+	}
+	if s.batch == nil {
+		_, s.batch = tracer().Start(s.ctx, s.name+" batch",
+			trace.WithAttributes(attribute.Int("rpc.batch.index", s.batchIndex)))
+		s.batchStart = time.Now()
+		s.batchIndex++
+	}
+	if received {
+		s.received++
+		s.totalReceived++
+	} else {
+		s.sent++
+		s.totalSent++
+	}
+}
+
+func (s *tracedServerStream) endBatch() {
+	if s.batch == nil {
+		return
+	}
+	s.batch.SetAttributes(
+		attribute.Int("rpc.messages_received", s.received),
+		attribute.Int("rpc.messages_sent", s.sent),
+	)
+	s.batch.End()
+	s.batch, s.received, s.sent = nil, 0, 0
+}
+
+func (s *tracedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.message(true)
+	}
+	return err
+}
+
+func (s *tracedServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.message(false)
+	}
+	return err
+}
+
+// startClientSpan starts the span of an outgoing call and puts its trace context in the outgoing metadata
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	name, attrs := rpcSpan(fullMethod)
+	ctx, span := tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// UnaryClientInterceptor traces unary calls of a client and propagates their trace context
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, method)
+		defer span.End()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		finishRPC(span, err, false)
+		return err
+	}
+}
+
+// StreamClientInterceptor traces streaming calls of a client and propagates their trace context
+// the span ends when the stream finishes or its context is done
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			finishRPC(span, err, false)
+			span.End()
+			return nil, err
+		}
+
+		stream := &tracedClientStream{ClientStream: cs, span: span, serverStreams: desc.ServerStreams, done: make(chan struct{})}
+		go func() {
+			// streams abandoned by the caller end with their context
+			select {
+			case <-ctx.Done():
+				stream.finish(ctx.Err())
+			case <-stream.done:
+			}
+		}()
+		return stream, nil
+	}
+}
+
+// tracedClientStream ends the span of a client stream once the last response is received
+type tracedClientStream struct {
+	grpc.ClientStream
+	span          trace.Span
+	serverStreams bool
+	once          sync.Once
+	done          chan struct{} // closed once the span ended
+}
+
+func (s *tracedClientStream) finish(err error) {
+	s.once.Do(func() {
+		if err == io.EOF {
+			err = nil
+		}
+		finishRPC(s.span, err, false)
+		s.span.End()
+		close(s.done)
+	})
+}
+
+func (s *tracedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err != nil:
+		s.finish(err)
+	case !s.serverStreams:
+		// client streaming calls receive a single response
+		s.finish(nil)
+	}
+	return err
+}
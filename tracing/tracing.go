@@ -0,0 +1,122 @@
+// Package tracing sets up OpenTelemetry tracing of the servers and clients.
+//
+// Trace context travels between clients and servers in the W3C traceparent and tracestate grpc metadata,
+// so a call from a client binary, the server handling it and the store operations it runs
+// end up in one trace. Spans are exported to stdout, an OTLP collector, or kept in memory for tests.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of this repository
+const instrumentationName = "github.com/rahulsingh/go-grpc-examples/tracing"
+
+// Exporters accepted in Options.Exporter
+const (
+	ExporterNone   = "none"   // propagate trace context but record nothing
+	ExporterStdout = "stdout" // pretty printed JSON spans on stdout
+	ExporterOTLP   = "otlp"   // OTLP over grpc to a collector such as the OpenTelemetry Collector or Jaeger
+)
+
+// Options configures tracing of one binary
+type Options struct {
+	ServiceName  string  // service.name resource attribute, the binary name if empty
+	Exporter     string  // none, stdout or otlp
+	OTLPEndpoint string  // host:port of the OTLP grpc receiver
+	OTLPInsecure bool    // connect to the OTLP receiver without TLS
+	SampleRatio  float64 // fraction of new traces recorded, calls of sampled parents are always recorded
+}
+
+// Shutdown flushes the spans still buffered and stops the exporter
+type Shutdown func(context.Context) error
+
+// Setup installs the global tracer provider and the W3C propagators
+func Setup(opts Options) (Shutdown, error) {
+	// always propagate, so a server without exporter still links the traces of its clients and callees
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.OTLPEndpoint)}
+		if opts.OTLPInsecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		// the exporter connects lazily, an unreachable collector does not stop the binary
+		exporter, err = otlptracegrpc.New(context.Background(), clientOpts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q, use none, stdout or otlp", opts.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while creating trace exporter: %v", err)
+	}
+
+	if opts.ServiceName == "" {
+		opts.ServiceName = filepath.Base(os.Args[0])
+	}
+	provider := newProvider(opts.ServiceName, sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(5*time.Second)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// SetupInMemory installs a tracer provider recording every span synchronously in the returned exporter,
+// for tests asserting on the spans of a call
+func SetupInMemory(serviceName string) (*tracetest.InMemoryExporter, Shutdown) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	exporter := tracetest.NewInMemoryExporter()
+	provider := newProvider(serviceName, sdktrace.WithSyncer(exporter), sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	otel.SetTracerProvider(provider)
+	return exporter, provider.Shutdown
+}
+
+func newProvider(serviceName string, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	res := resource.NewSchemaless(attribute.String("service.name", serviceName))
+	return sdktrace.NewTracerProvider(append(opts, sdktrace.WithResource(res))...)
+}
+
+// tracer returns the tracer of the current global provider, so providers installed later are used
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartStoreSpan starts the span of a store operation, e.g. operation find on collection blog
+// end records err, if any, and ends the span
+func StartStoreSpan(ctx context.Context, system, collection, operation string) (context.Context, func(err error)) {
+	ctx, span := tracer().Start(ctx, collection+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", system),
+			attribute.String("db.operation", operation),
+			attribute.String("db.collection", collection),
+		))
+	return ctx, func(err error) {
+		recordError(span, err)
+		span.End()
+	}
+}
+
+// StartSpan starts an internal span, e.g. of background work not started by a call
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}